@@ -2,24 +2,63 @@ package artifact_registry_docker_images_client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/imroc/req/v3"
 	"golang.org/x/oauth2/google"
+	"net/http"
+	"strconv"
+	"strings"
 )
 
 const (
 	apiBaseUrl = "https://artifactregistry.googleapis.com/v1/"
+	// maxPageSize is the largest page size the list endpoints accept.
+	maxPageSize = 200
+	// grpcCodeNotFound is the gRPC code of NOT_FOUND errors.
+	grpcCodeNotFound = 5
 )
 
 type ErrorMessage struct {
 	Message string `json:"message"`
+	// Status is the error envelope of Google APIs, e.g. {"error": {"code": 404, "message": "...", "status": "NOT_FOUND"}}.
+	Status *Status `json:"error"`
 }
 
 // Error implements go error interface.
 func (msg *ErrorMessage) Error() string {
+	if msg.Status != nil {
+		return fmt.Sprintf("API Error: %s", msg.Status.Message)
+	}
 	return fmt.Sprintf("API Error: %s", msg.Message)
 }
 
+// Status is the error model of Google APIs, also used to report failed operations.
+type Status struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Status  string `json:"status"`
+}
+
+// Error implements go error interface.
+func (s *Status) Error() string {
+	if s.Status == "" {
+		return s.Message
+	}
+	return fmt.Sprintf("%s: %s", s.Status, s.Message)
+}
+
+// IsNotFound reports whether err is an API or operation error for a resource that does not exist.
+func IsNotFound(err error) bool {
+	var errMsg *ErrorMessage
+	if errors.As(err, &errMsg) {
+		return errMsg.Status != nil && errMsg.Status.Code == http.StatusNotFound
+	}
+	// Operations report their errors with gRPC codes instead of HTTP status codes.
+	var status *Status
+	return errors.As(err, &status) && status.Code == grpcCodeNotFound
+}
+
 // ClientAccessTokenOauthResponse is the response from the TikTok OAuth endpoint
 type ClientAccessTokenOauthResponse struct {
 	AccessToken string `json:"access_token"`
@@ -30,6 +69,9 @@ type ClientAccessTokenOauthResponse struct {
 
 type Client struct {
 	*req.Client
+	// registry talks to the Docker registry of Location, for what the Artifact Registry API does not expose
+	// such as manifests and blobs.
+	registry   *req.Client
 	ProjectID  string
 	Location   string
 	Repository string
@@ -74,6 +116,7 @@ func NewClient(reqClient *req.Client, options *Options) (*Client, error) {
 
 	newClient := &Client{
 		Client:     reqClient,
+		registry:   newRegistryClient(options.Location, token.AccessToken),
 		ProjectID:  options.ProjectID,
 		Location:   options.Location,
 		Repository: options.Repository,
@@ -97,27 +140,58 @@ type DockerImage struct {
 	UpdateTime     string   `json:"updateTime"`
 }
 
+// ImageAndDigest splits the URI of the docker image into the image name and the digest, e.g. campaign-service and sha256:...
+func (i *DockerImage) ImageAndDigest() (string, string) {
+	imagePath, digest, _ := strings.Cut(i.Uri, "@")
+	// The URI is made of location host, project, repository and image, e.g. europe-docker.pkg.dev/devops-339608/services/campaign-service.
+	segments := strings.SplitN(imagePath, "/", 4)
+	return segments[len(segments)-1], digest
+}
+
 // ListImages hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.dockerImages/get
 // to list the images in the registry.
 func (c *Client) ListImages(ctx context.Context) ([]DockerImage, error) {
-	var dockerImages []DockerImage
-	hasNextPage := true
+	url := fmt.Sprintf("%s/dockerImages/", c.repositoryPath())
+	return listAll(ctx, c, url, nil, 0, func(page *ListImagesResponse) ([]DockerImage, string) {
+		return page.DockerImages, page.NextPageToken
+	})
+}
+
+// repositoryPath is the resource name of the repository the client is configured for.
+func (c *Client) repositoryPath() string {
+	return fmt.Sprintf("projects/%s/locations/%s/repositories/%s", c.ProjectID, c.Location, c.Repository)
+}
+
+// listAll requests url page by page until the API stops returning a nextPageToken, or limit items have been read
+// when limit is positive. items extracts the items and the nextPageToken of a single page.
+func listAll[Page any, Item any](ctx context.Context, c *Client, url string, params map[string]string, limit int, items func(page *Page) ([]Item, string)) ([]Item, error) {
+	pageSize := maxPageSize
+	if limit > 0 && limit < pageSize {
+		pageSize = limit
+	}
+	var all []Item
 	var nextPageToken string
-	for hasNextPage {
-		var listImagesResponse ListImagesResponse
-		request := c.R().SetURL(fmt.Sprintf("projects/%s/locations/%s/repositories/%s/dockerImages/", c.ProjectID, c.Location, c.Repository)).
-			SetSuccessResult(&listImagesResponse).
-			SetQueryParam("pageSize", "200")
+	for {
+		var page Page
+		request := c.R().SetURL(url).
+			SetSuccessResult(&page).
+			SetQueryParams(params).
+			SetQueryParam("pageSize", strconv.Itoa(pageSize))
 		if nextPageToken != "" {
 			request.SetQueryParam("pageToken", nextPageToken)
 		}
 		res := request.Do(ctx)
-		if res.IsErrorState() {
+		if res.Err != nil {
 			return nil, res.Err
 		}
-		dockerImages = append(dockerImages, listImagesResponse.DockerImages...)
-		hasNextPage = listImagesResponse.NextPageToken != ""
-		nextPageToken = listImagesResponse.NextPageToken
+		var pageItems []Item
+		pageItems, nextPageToken = items(&page)
+		all = append(all, pageItems...)
+		if limit > 0 && len(all) >= limit {
+			return all[:limit], nil
+		}
+		if nextPageToken == "" {
+			return all, nil
+		}
 	}
-	return dockerImages, nil
 }
@@ -0,0 +1,77 @@
+package artifact_registry_docker_images_client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// containerAnalysisBaseUrl is the base URL of the Container Analysis API, which stores the vulnerability scan
+// results of the images of Artifact Registry.
+const containerAnalysisBaseUrl = "https://containeranalysis.googleapis.com/v1/"
+
+type ListOccurrencesResponse struct {
+	Occurrences   []Occurrence `json:"occurrences"`
+	NextPageToken string       `json:"nextPageToken"`
+}
+
+// Occurrence is an instance of a note on a resource, e.g. a vulnerability found in an image.
+type Occurrence struct {
+	Name        string `json:"name"`
+	ResourceUri string `json:"resourceUri"`
+	// NoteName is the resource name of the note, e.g. projects/goog-vulnz/notes/CVE-2023-4911.
+	NoteName      string                   `json:"noteName"`
+	Kind          string                   `json:"kind"`
+	Vulnerability *VulnerabilityOccurrence `json:"vulnerability"`
+	CreateTime    string                   `json:"createTime"`
+	UpdateTime    string                   `json:"updateTime"`
+}
+
+// VulnerabilityOccurrence holds the details of an occurrence of kind VULNERABILITY.
+type VulnerabilityOccurrence struct {
+	// Severity is the severity assigned by the note, one of MINIMAL, LOW, MEDIUM, HIGH or CRITICAL.
+	Severity string `json:"severity"`
+	// EffectiveSeverity is the severity assigned by the distribution of the affected package, falling back to Severity.
+	EffectiveSeverity string         `json:"effectiveSeverity"`
+	CvssScore         float64        `json:"cvssScore"`
+	CvssVersion       string         `json:"cvssVersion"`
+	FixAvailable      bool           `json:"fixAvailable"`
+	ShortDescription  string         `json:"shortDescription"`
+	PackageIssue      []PackageIssue `json:"packageIssue"`
+}
+
+// PackageIssue describes a package of the image affected by the vulnerability.
+type PackageIssue struct {
+	AffectedPackage string         `json:"affectedPackage"`
+	AffectedVersion PackageVersion `json:"affectedVersion"`
+	FixedVersion    PackageVersion `json:"fixedVersion"`
+	FixAvailable    bool           `json:"fixAvailable"`
+	PackageType     string         `json:"packageType"`
+}
+
+type PackageVersion struct {
+	// Kind is MAXIMUM for the fixed version of a vulnerability without a fix.
+	Kind     string `json:"kind"`
+	FullName string `json:"fullName"`
+}
+
+// NoteID returns the ID of the note of the occurrence, which is the CVE ID for vulnerabilities, e.g. CVE-2023-4911.
+func (o *Occurrence) NoteID() string {
+	return o.NoteName[strings.LastIndex(o.NoteName, "/")+1:]
+}
+
+// ImageResourceURL returns the URL Container Analysis knows an image of the repository by,
+// e.g. https://europe-docker.pkg.dev/devops-339608/services/campaign-service@sha256:...
+func (c *Client) ImageResourceURL(image string, digest string) string {
+	return fmt.Sprintf("https://%s/%s@%s", DockerRegistryHost(c.Location), c.registryImagePath(image), digest)
+}
+
+// ListVulnerabilityOccurrences hits https://cloud.google.com/container-analysis/docs/reference/rest/v1/projects.occurrences/list
+// to list the vulnerabilities found in the resource, e.g. the URL of an image returned by ImageResourceURL.
+func (c *Client) ListVulnerabilityOccurrences(ctx context.Context, resourceURL string) ([]Occurrence, error) {
+	occurrencesURL := fmt.Sprintf("%sprojects/%s/occurrences", containerAnalysisBaseUrl, c.ProjectID)
+	params := map[string]string{"filter": fmt.Sprintf("kind=%q AND resourceUrl=%q", "VULNERABILITY", resourceURL)}
+	return listAll(ctx, c, occurrencesURL, params, 0, func(page *ListOccurrencesResponse) ([]Occurrence, string) {
+		return page.Occurrences, page.NextPageToken
+	})
+}
@@ -0,0 +1,194 @@
+package artifact_registry_docker_images_client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"strings"
+)
+
+// uploadBaseUrl is the base URL of the media upload endpoints, which live outside of apiBaseUrl.
+const uploadBaseUrl = "https://artifactregistry.googleapis.com/upload/v1/"
+
+// File is a file of a repository, e.g. a generic artifact or the archive of a package version.
+type File struct {
+	Name string `json:"name"`
+	// SizeBytes is an int64 encoded as a JSON string.
+	SizeBytes  string `json:"sizeBytes"`
+	Hashes     []Hash `json:"hashes"`
+	CreateTime string `json:"createTime"`
+	UpdateTime string `json:"updateTime"`
+	// Owner is the resource name of the version the file belongs to.
+	Owner string `json:"owner"`
+}
+
+type Hash struct {
+	// Type is either SHA256 or MD5.
+	Type string `json:"type"`
+	// Value is base64 encoded.
+	Value string `json:"value"`
+}
+
+type ListFilesResponse struct {
+	Files         []File `json:"files"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+// ID returns the file ID, e.g. charts:1.4.0:campaign-service-1.4.0.tgz for generic artifacts.
+func (f *File) ID() string {
+	return unescapeResourceID(f.Name)
+}
+
+// SHA256 returns the hex encoded SHA256 hash of the file, or an empty string when the API did not report one.
+func (f *File) SHA256() string {
+	return f.hash("SHA256")
+}
+
+// MD5 returns the hex encoded MD5 hash of the file, or an empty string when the API did not report one.
+func (f *File) MD5() string {
+	return f.hash("MD5")
+}
+
+func (f *File) hash(hashType string) string {
+	for _, hash := range f.Hashes {
+		if hash.Type != hashType {
+			continue
+		}
+		if value, err := base64.StdEncoding.DecodeString(hash.Value); err == nil {
+			return hex.EncodeToString(value)
+		}
+	}
+	return ""
+}
+
+type uploadGenericArtifactMetadata struct {
+	PackageID string `json:"packageId"`
+	VersionID string `json:"versionId"`
+	Filename  string `json:"filename"`
+}
+
+type uploadGenericArtifactResponse struct {
+	Operation Operation `json:"operation"`
+}
+
+// GenericArtifactFileID returns the ID of the file of a generic artifact, e.g. charts:1.4.0:campaign-service-1.4.0.tgz.
+func GenericArtifactFileID(packageID string, versionID string, filename string) string {
+	return strings.Join([]string{packageID, versionID, filename}, ":")
+}
+
+// ListFiles hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.files/list
+// to list the files of a version of a package.
+func (c *Client) ListFiles(ctx context.Context, packageID string, versionID string) ([]File, error) {
+	filesURL := fmt.Sprintf("%s/files", c.repositoryPath())
+	owner := fmt.Sprintf("%s/versions/%s", c.packagePath(packageID), url.PathEscape(versionID))
+	params := map[string]string{"filter": fmt.Sprintf("owner=%q", owner)}
+	return listAll(ctx, c, filesURL, params, 0, func(page *ListFilesResponse) ([]File, string) {
+		return page.Files, page.NextPageToken
+	})
+}
+
+// GetFile hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.files/get
+// to get a file of the repository, including its hashes.
+func (c *Client) GetFile(ctx context.Context, fileID string) (*File, error) {
+	var file File
+	res := c.R().SetURL(c.filePath(fileID)).
+		SetSuccessResult(&file).
+		Do(ctx)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	return &file, nil
+}
+
+// DeleteFile hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.files/delete
+// to start deleting a file of the repository.
+func (c *Client) DeleteFile(ctx context.Context, fileID string) (*Operation, error) {
+	var operation Operation
+	_, err := c.R().SetContext(ctx).
+		SetSuccessResult(&operation).
+		Delete(c.filePath(fileID))
+	if err != nil {
+		return nil, err
+	}
+	return &operation, nil
+}
+
+// DownloadFile hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.files/download
+// to write the content of a file of the repository to w.
+func (c *Client) DownloadFile(ctx context.Context, fileID string, w io.Writer) error {
+	// The content is streamed into w instead of being read into memory, which includes leaving it out of the dump.
+	res := c.R().SetURL(fmt.Sprintf("%s:download", c.filePath(fileID))).
+		SetQueryParam("alt", "media").
+		EnableDumpWithoutResponseBody().
+		DisableAutoReadResponse().
+		Do(ctx)
+	if res.Err != nil {
+		return res.Err
+	}
+	defer res.Body.Close()
+	_, err := io.Copy(w, res.Body)
+	return err
+}
+
+// UploadGenericArtifact hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/media/upload
+// to start uploading content as the file of a version of a generic package. content is streamed, not buffered.
+func (c *Client) UploadGenericArtifact(ctx context.Context, packageID string, versionID string, filename string, content io.Reader) (*Operation, error) {
+	metadata, err := json.Marshal(uploadGenericArtifactMetadata{PackageID: packageID, VersionID: versionID, Filename: filename})
+	if err != nil {
+		return nil, err
+	}
+	body, writer := io.Pipe()
+	multipartWriter := multipart.NewWriter(writer)
+	go func() {
+		writer.CloseWithError(writeGenericArtifactParts(multipartWriter, metadata, filename, content))
+	}()
+
+	var response uploadGenericArtifactResponse
+	_, err = c.R().SetContext(ctx).
+		SetQueryParams(map[string]string{"alt": "json", "uploadType": "multipart"}).
+		// The dump of every request would otherwise keep a copy of the whole content in memory.
+		EnableDumpWithoutRequestBody().
+		SetContentType(multipartWriter.FormDataContentType()).
+		SetBody(body).
+		SetSuccessResult(&response).
+		Post(fmt.Sprintf("%s%s/genericArtifacts:create", uploadBaseUrl, c.repositoryPath()))
+	// Unblock the writer when the request failed before reading the whole body.
+	body.Close()
+	if err != nil {
+		return nil, err
+	}
+	return &response.Operation, nil
+}
+
+// writeGenericArtifactParts writes the metadata part followed by the content part of an upload.
+func writeGenericArtifactParts(multipartWriter *multipart.Writer, metadata []byte, filename string, content io.Reader) error {
+	metadataHeader := textproto.MIMEHeader{}
+	metadataHeader.Set("Content-Disposition", `form-data; name="meta"`)
+	metadataHeader.Set("Content-Type", "application/json")
+	metadataPart, err := multipartWriter.CreatePart(metadataHeader)
+	if err != nil {
+		return err
+	}
+	if _, err := metadataPart.Write(metadata); err != nil {
+		return err
+	}
+	contentPart, err := multipartWriter.CreateFormFile("blob", filename)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(contentPart, content); err != nil {
+		return err
+	}
+	return multipartWriter.Close()
+}
+
+// filePath is the resource name of a file in the repository the client is configured for.
+func (c *Client) filePath(fileID string) string {
+	return fmt.Sprintf("%s/files/%s", c.repositoryPath(), url.PathEscape(fileID))
+}
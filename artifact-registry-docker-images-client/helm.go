@@ -0,0 +1,36 @@
+package artifact_registry_docker_images_client
+
+import (
+	"context"
+	"fmt"
+)
+
+// MediaTypeHelmChartConfig is the config media type of the manifest of a chart pushed with helm push.
+const MediaTypeHelmChartConfig = "application/vnd.cncf.helm.config.v1+json"
+
+// HelmChartMetadata is the config blob of a chart, which holds the metadata of its Chart.yaml as JSON.
+type HelmChartMetadata struct {
+	APIVersion  string `json:"apiVersion"`
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	AppVersion  string `json:"appVersion"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+}
+
+// IsHelmChart reports whether the manifest is the manifest of a chart rather than of a container image.
+func (m *Manifest) IsHelmChart() bool {
+	return !m.IsIndex() && m.Config.MediaType == MediaTypeHelmChartConfig
+}
+
+// GetHelmChartMetadata fetches and decodes the config blob referenced by the manifest of a chart.
+func (c *Client) GetHelmChartMetadata(ctx context.Context, image string, manifest *Manifest) (*HelmChartMetadata, error) {
+	if !manifest.IsHelmChart() {
+		return nil, fmt.Errorf("manifest %s is not a helm chart", manifest.Digest)
+	}
+	var metadata HelmChartMetadata
+	if err := c.getBlob(ctx, image, manifest.Config.Digest, &metadata); err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
@@ -0,0 +1,98 @@
+package artifact_registry_docker_images_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Policy is the IAM policy of a resource, see https://cloud.google.com/iam/docs/reference/rest/v1/Policy.
+type Policy struct {
+	Version  int       `json:"version,omitempty"`
+	Bindings []Binding `json:"bindings,omitempty"`
+	// Etag is used for optimistic concurrency control: setting a policy with an outdated etag fails with a conflict.
+	Etag string `json:"etag,omitempty"`
+}
+
+// Binding grants a role to members, optionally only when the condition holds.
+type Binding struct {
+	Role      string   `json:"role"`
+	Members   []string `json:"members"`
+	Condition *Expr    `json:"condition,omitempty"`
+}
+
+// Expr is a CEL expression, see https://cloud.google.com/iam/docs/conditions-overview.
+type Expr struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Expression  string `json:"expression"`
+}
+
+// PolicyVersionConditions is the policy version that supports conditional bindings. Reading a policy with conditions
+// in an older version drops the conditions, and writing it back would grant the roles unconditionally.
+const PolicyVersionConditions = 3
+
+type setIamPolicyRequest struct {
+	Policy *Policy `json:"policy"`
+}
+
+// GetIamPolicy hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories/getIamPolicy
+// to get the IAM policy of a repository, including its conditional bindings.
+func (c *Client) GetIamPolicy(ctx context.Context, repositoryName string) (*Policy, error) {
+	var policy Policy
+	res := c.R().SetURL(fmt.Sprintf("%s:getIamPolicy", repositoryName)).
+		SetQueryParam("options.requestedPolicyVersion", strconv.Itoa(PolicyVersionConditions)).
+		SetSuccessResult(&policy).
+		Do(ctx)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	return &policy, nil
+}
+
+// SetIamPolicy hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories/setIamPolicy
+// to replace the IAM policy of a repository. The call fails with a conflict, see IsConflict, when the etag of policy is
+// outdated. Policies with conditional bindings are written as PolicyVersionConditions.
+func (c *Client) SetIamPolicy(ctx context.Context, repositoryName string, policy *Policy) (*Policy, error) {
+	body := *policy
+	for _, binding := range body.Bindings {
+		if binding.Condition != nil && body.Version < PolicyVersionConditions {
+			body.Version = PolicyVersionConditions
+		}
+	}
+	var updated Policy
+	_, err := c.R().SetContext(ctx).
+		SetBodyJsonMarshal(&setIamPolicyRequest{Policy: &body}).
+		SetSuccessResult(&updated).
+		Post(fmt.Sprintf("%s:setIamPolicy", repositoryName))
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// IsConflict reports whether err is an API error for a resource that was concurrently modified.
+func IsConflict(err error) bool {
+	var errMsg *ErrorMessage
+	return errors.As(err, &errMsg) && errMsg.Status != nil && errMsg.Status.Code == http.StatusConflict
+}
+
+type testIamPermissionsMessage struct {
+	Permissions []string `json:"permissions"`
+}
+
+// TestIamPermissions hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories/testIamPermissions
+// to get the subset of permissions the caller has on a repository.
+func (c *Client) TestIamPermissions(ctx context.Context, repositoryName string, permissions []string) ([]string, error) {
+	var granted testIamPermissionsMessage
+	_, err := c.R().SetContext(ctx).
+		SetBodyJsonMarshal(&testIamPermissionsMessage{Permissions: permissions}).
+		SetSuccessResult(&granted).
+		Post(fmt.Sprintf("%s:testIamPermissions", repositoryName))
+	if err != nil {
+		return nil, err
+	}
+	return granted.Permissions, nil
+}
@@ -0,0 +1,27 @@
+package artifact_registry_docker_images_client
+
+import (
+	"context"
+	"fmt"
+)
+
+type ListLocationsResponse struct {
+	Locations     []Location `json:"locations"`
+	NextPageToken string     `json:"nextPageToken"`
+}
+
+type Location struct {
+	Name        string            `json:"name"`
+	LocationID  string            `json:"locationId"`
+	DisplayName string            `json:"displayName"`
+	Labels      map[string]string `json:"labels"`
+}
+
+// ListLocations hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations/list
+// to list the Artifact Registry locations available to the project.
+func (c *Client) ListLocations(ctx context.Context) ([]Location, error) {
+	url := fmt.Sprintf("projects/%s/locations", c.ProjectID)
+	return listAll(ctx, c, url, nil, 0, func(page *ListLocationsResponse) ([]Location, string) {
+		return page.Locations, page.NextPageToken
+	})
+}
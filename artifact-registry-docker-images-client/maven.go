@@ -0,0 +1,41 @@
+package artifact_registry_docker_images_client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type ListMavenArtifactsResponse struct {
+	MavenArtifacts []MavenArtifact `json:"mavenArtifacts"`
+	NextPageToken  string          `json:"nextPageToken"`
+}
+
+type MavenArtifact struct {
+	Name       string `json:"name"`
+	PomURI     string `json:"pomUri"`
+	GroupID    string `json:"groupId"`
+	ArtifactID string `json:"artifactId"`
+	Version    string `json:"version"`
+	CreateTime string `json:"createTime"`
+	UpdateTime string `json:"updateTime"`
+}
+
+// Coordinates returns the group ID and artifact ID of the artifact, e.g. com.fourthfloor:campaign-client.
+func (a *MavenArtifact) Coordinates() string {
+	return fmt.Sprintf("%s:%s", a.GroupID, a.ArtifactID)
+}
+
+// IsSnapshot reports whether the artifact is a development version, e.g. 1.2.0-SNAPSHOT, rather than a release.
+func (a *MavenArtifact) IsSnapshot() bool {
+	return strings.HasSuffix(a.Version, "-SNAPSHOT")
+}
+
+// ListMavenArtifacts hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.mavenArtifacts/list
+// to list the maven artifacts in the repository.
+func (c *Client) ListMavenArtifacts(ctx context.Context) ([]MavenArtifact, error) {
+	url := fmt.Sprintf("%s/mavenArtifacts", c.repositoryPath())
+	return listAll(ctx, c, url, nil, 0, func(page *ListMavenArtifactsResponse) ([]MavenArtifact, string) {
+		return page.MavenArtifacts, page.NextPageToken
+	})
+}
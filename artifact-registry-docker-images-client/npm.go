@@ -0,0 +1,31 @@
+package artifact_registry_docker_images_client
+
+import (
+	"context"
+	"fmt"
+)
+
+type ListNpmPackagesResponse struct {
+	NpmPackages   []NpmPackage `json:"npmPackages"`
+	NextPageToken string       `json:"nextPageToken"`
+}
+
+// NpmPackage is a single version of an npm package.
+type NpmPackage struct {
+	Name        string `json:"name"`
+	PackageName string `json:"packageName"`
+	Version     string `json:"version"`
+	// Tags are the dist-tags pointing to the version, e.g. latest.
+	Tags       []string `json:"tags"`
+	CreateTime string   `json:"createTime"`
+	UpdateTime string   `json:"updateTime"`
+}
+
+// ListNpmPackages hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.npmPackages/list
+// to list the versions of the npm packages in the repository.
+func (c *Client) ListNpmPackages(ctx context.Context) ([]NpmPackage, error) {
+	url := fmt.Sprintf("%s/npmPackages", c.repositoryPath())
+	return listAll(ctx, c, url, nil, 0, func(page *ListNpmPackagesResponse) ([]NpmPackage, string) {
+		return page.NpmPackages, page.NextPageToken
+	})
+}
@@ -0,0 +1,58 @@
+package artifact_registry_docker_images_client
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+const (
+	operationPollInterval    = 2 * time.Second
+	operationMaxPollInterval = 30 * time.Second
+)
+
+// Operation is a long-running operation, as returned by the mutating calls of the API.
+type Operation struct {
+	Name  string  `json:"name"`
+	Done  bool    `json:"done"`
+	Error *Status `json:"error"`
+	// Response is the result of the operation once it is done, e.g. the created repository.
+	Response json.RawMessage `json:"response"`
+}
+
+// GetOperation hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.operations/get
+// to get the latest state of an operation.
+func (c *Client) GetOperation(ctx context.Context, name string) (*Operation, error) {
+	var operation Operation
+	res := c.R().SetURL(name).SetSuccessResult(&operation).Do(ctx)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	return &operation, nil
+}
+
+// WaitForOperation polls operation until it is done or ctx is cancelled, and returns the error of the operation if
+// it failed.
+func (c *Client) WaitForOperation(ctx context.Context, operation *Operation) (*Operation, error) {
+	interval := operationPollInterval
+	for !operation.Done {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+		var err error
+		operation, err = c.GetOperation(ctx, operation.Name)
+		if err != nil {
+			return nil, err
+		}
+		interval *= 2
+		if interval > operationMaxPollInterval {
+			interval = operationMaxPollInterval
+		}
+	}
+	if operation.Error != nil {
+		return nil, operation.Error
+	}
+	return operation, nil
+}
@@ -0,0 +1,63 @@
+package artifact_registry_docker_images_client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+type ListPackagesResponse struct {
+	Packages      []Package `json:"packages"`
+	NextPageToken string    `json:"nextPageToken"`
+}
+
+type Package struct {
+	Name        string            `json:"name"`
+	DisplayName string            `json:"displayName"`
+	CreateTime  string            `json:"createTime"`
+	UpdateTime  string            `json:"updateTime"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+// ID returns the package ID, the unescaped last segment of the package name, e.g. campaign-service.
+func (p *Package) ID() string {
+	return unescapeResourceID(p.Name)
+}
+
+// ListPackages hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.packages/list
+// to list the packages in the repository.
+func (c *Client) ListPackages(ctx context.Context) ([]Package, error) {
+	url := fmt.Sprintf("%s/packages", c.repositoryPath())
+	return listAll(ctx, c, url, nil, 0, func(page *ListPackagesResponse) ([]Package, string) {
+		return page.Packages, page.NextPageToken
+	})
+}
+
+// DeletePackage hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.packages/delete
+// to delete a package with all of its versions and tags.
+func (c *Client) DeletePackage(ctx context.Context, packageID string) (*Operation, error) {
+	var operation Operation
+	_, err := c.R().SetContext(ctx).
+		SetSuccessResult(&operation).
+		Delete(c.packagePath(packageID))
+	if err != nil {
+		return nil, err
+	}
+	return &operation, nil
+}
+
+// packagePath is the resource name of a package in the repository the client is configured for.
+func (c *Client) packagePath(packageID string) string {
+	return fmt.Sprintf("%s/packages/%s", c.repositoryPath(), url.PathEscape(packageID))
+}
+
+// unescapeResourceID returns the unescaped last segment of a resource name. Package IDs containing slashes, such as
+// nested docker image names, are URL-encoded in resource names.
+func unescapeResourceID(name string) string {
+	id := path.Base(name)
+	if unescaped, err := url.PathUnescape(id); err == nil {
+		return unescaped
+	}
+	return id
+}
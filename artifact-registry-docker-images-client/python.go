@@ -0,0 +1,30 @@
+package artifact_registry_docker_images_client
+
+import (
+	"context"
+	"fmt"
+)
+
+type ListPythonPackagesResponse struct {
+	PythonPackages []PythonPackage `json:"pythonPackages"`
+	NextPageToken  string          `json:"nextPageToken"`
+}
+
+// PythonPackage is a single version of a python package.
+type PythonPackage struct {
+	Name        string `json:"name"`
+	URI         string `json:"uri"`
+	PackageName string `json:"packageName"`
+	Version     string `json:"version"`
+	CreateTime  string `json:"createTime"`
+	UpdateTime  string `json:"updateTime"`
+}
+
+// ListPythonPackages hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.pythonPackages/list
+// to list the versions of the python packages in the repository.
+func (c *Client) ListPythonPackages(ctx context.Context) ([]PythonPackage, error) {
+	url := fmt.Sprintf("%s/pythonPackages", c.repositoryPath())
+	return listAll(ctx, c, url, nil, 0, func(page *ListPythonPackagesResponse) ([]PythonPackage, string) {
+		return page.PythonPackages, page.NextPageToken
+	})
+}
@@ -0,0 +1,216 @@
+package artifact_registry_docker_images_client
+
+import (
+	"context"
+	"fmt"
+	"github.com/imroc/req/v3"
+	"strings"
+)
+
+const (
+	// dockerRegistryHostFormat is the host of the Docker registry of a location, e.g. europe-docker.pkg.dev.
+	dockerRegistryHostFormat = "%s-docker.pkg.dev"
+	// aptRepositoryHostFormat and yumRepositoryHostFormat are the hosts serving the apt and yum repositories of a
+	// location, e.g. europe-apt.pkg.dev.
+	aptRepositoryHostFormat = "%s-apt.pkg.dev"
+	yumRepositoryHostFormat = "%s-yum.pkg.dev"
+
+	MediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	MediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	MediaTypeOCIManifest        = "application/vnd.oci.image.manifest.v1+json"
+	MediaTypeOCIIndex           = "application/vnd.oci.image.index.v1+json"
+)
+
+// manifestAcceptHeader lists every manifest media type the registry is allowed to answer with, so that
+// multi-arch images come back as an index instead of being resolved to a single platform.
+var manifestAcceptHeader = strings.Join([]string{
+	MediaTypeOCIIndex,
+	MediaTypeDockerManifestList,
+	MediaTypeOCIManifest,
+	MediaTypeDockerManifest,
+}, ", ")
+
+// RegistryError is a single error as returned by the Docker Registry HTTP API V2.
+type RegistryError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// RegistryErrors is the error body returned by the Docker Registry HTTP API V2.
+type RegistryErrors struct {
+	Errors []RegistryError `json:"errors"`
+}
+
+// Error implements go error interface.
+func (e *RegistryErrors) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, registryError := range e.Errors {
+		messages = append(messages, fmt.Sprintf("%s: %s", registryError.Code, registryError.Message))
+	}
+	return fmt.Sprintf("Registry Error: %s", strings.Join(messages, "; "))
+}
+
+// DockerRegistryHost returns the host of the Docker registry of a location, e.g. europe-docker.pkg.dev.
+func DockerRegistryHost(location string) string {
+	return fmt.Sprintf(dockerRegistryHostFormat, location)
+}
+
+// AptRepositoryHost returns the host serving the apt repositories of a location, e.g. europe-apt.pkg.dev.
+func AptRepositoryHost(location string) string {
+	return fmt.Sprintf(aptRepositoryHostFormat, location)
+}
+
+// YumRepositoryHost returns the host serving the yum repositories of a location, e.g. europe-yum.pkg.dev.
+func YumRepositoryHost(location string) string {
+	return fmt.Sprintf(yumRepositoryHostFormat, location)
+}
+
+// newRegistryClient creates the client used to talk to the Docker registry of the given location.
+func newRegistryClient(location string, accessToken string) *req.Client {
+	return req.C().
+		SetBaseURL(fmt.Sprintf("https://%s/v2/", DockerRegistryHost(location))).
+		SetCommonErrorResult(&RegistryErrors{}).
+		OnAfterResponse(func(client *req.Client, resp *req.Response) error {
+			if resp.Err != nil {
+				return nil
+			}
+			if errs, ok := resp.ErrorResult().(*RegistryErrors); ok && len(errs.Errors) > 0 {
+				resp.Err = errs
+				return nil
+			}
+			if !resp.IsSuccessState() {
+				resp.Err = fmt.Errorf("bad status: %s", resp.Status)
+			}
+			return nil
+		}).
+		// Artifact Registry accepts an OAuth access token as the password of the oauth2accesstoken user.
+		SetCommonBasicAuth("oauth2accesstoken", accessToken)
+}
+
+// Platform describes the platform a manifest inside an index was built for.
+type Platform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+	OSVersion    string `json:"os.version,omitempty"`
+	Variant      string `json:"variant,omitempty"`
+}
+
+// String formats the platform the way docker's --platform flag does, e.g. linux/arm64/v8.
+func (p *Platform) String() string {
+	platform := fmt.Sprintf("%s/%s", p.OS, p.Architecture)
+	if p.Variant != "" {
+		platform = fmt.Sprintf("%s/%s", platform, p.Variant)
+	}
+	return platform
+}
+
+// Descriptor references a manifest or blob by its digest.
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Platform    *Platform         `json:"platform,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Manifest is either an image manifest or an index (manifest list), depending on MediaType.
+type Manifest struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	MediaType     string `json:"mediaType"`
+	// Digest is the digest of the manifest itself, as reported by the registry.
+	Digest    string       `json:"-"`
+	Config    Descriptor   `json:"config"`
+	Layers    []Descriptor `json:"layers"`
+	Manifests []Descriptor `json:"manifests"`
+}
+
+// IsIndex reports whether the manifest is an OCI index or a Docker manifest list.
+func (m *Manifest) IsIndex() bool {
+	return m.MediaType == MediaTypeOCIIndex || m.MediaType == MediaTypeDockerManifestList
+}
+
+// ImageConfig is the config blob referenced by an image manifest.
+type ImageConfig struct {
+	Architecture string             `json:"architecture"`
+	OS           string             `json:"os"`
+	OSVersion    string             `json:"os.version,omitempty"`
+	Variant      string             `json:"variant,omitempty"`
+	Created      string             `json:"created"`
+	Config       ImageRuntimeConfig `json:"config"`
+}
+
+// ImageRuntimeConfig holds the execution parameters an image was built with.
+type ImageRuntimeConfig struct {
+	User         string              `json:"User"`
+	ExposedPorts map[string]struct{} `json:"ExposedPorts"`
+	Env          []string            `json:"Env"`
+	Entrypoint   []string            `json:"Entrypoint"`
+	Cmd          []string            `json:"Cmd"`
+	WorkingDir   string              `json:"WorkingDir"`
+	Labels       map[string]string   `json:"Labels"`
+}
+
+// GetManifest hits the registry's /v2/<name>/manifests/<reference> endpoint, where reference is a tag or a digest.
+func (c *Client) GetManifest(ctx context.Context, image string, reference string) (*Manifest, error) {
+	var manifest Manifest
+	res := c.registry.R().
+		SetURL(fmt.Sprintf("%s/manifests/%s", c.registryImagePath(image), reference)).
+		SetHeader("Accept", manifestAcceptHeader).
+		SetSuccessResult(&manifest).
+		Do(ctx)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	if manifest.MediaType == "" {
+		// OCI manifests are not required to carry their media type in the body.
+		manifest.MediaType = strings.Split(res.GetContentType(), ";")[0]
+	}
+	manifest.Digest = res.GetHeader("Docker-Content-Digest")
+	if manifest.Digest == "" && strings.HasPrefix(reference, "sha256:") {
+		manifest.Digest = reference
+	}
+	return &manifest, nil
+}
+
+// GetPlatformManifest returns the image manifest of the given platform (e.g. linux/amd64) if manifest is an index,
+// and manifest itself otherwise.
+func (c *Client) GetPlatformManifest(ctx context.Context, image string, manifest *Manifest, platform string) (*Manifest, error) {
+	if !manifest.IsIndex() {
+		return manifest, nil
+	}
+	for _, descriptor := range manifest.Manifests {
+		if descriptor.Platform != nil && descriptor.Platform.String() == platform {
+			return c.GetManifest(ctx, image, descriptor.Digest)
+		}
+	}
+	return nil, fmt.Errorf("index %s has no manifest for platform %s", manifest.Digest, platform)
+}
+
+// GetImageConfig fetches and decodes the config blob referenced by an image manifest.
+func (c *Client) GetImageConfig(ctx context.Context, image string, manifest *Manifest) (*ImageConfig, error) {
+	if manifest.IsIndex() {
+		return nil, fmt.Errorf("manifest %s is an index and has no image config", manifest.Digest)
+	}
+	var config ImageConfig
+	if err := c.getBlob(ctx, image, manifest.Config.Digest, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// getBlob hits the registry's /v2/<name>/blobs/<digest> endpoint and decodes the JSON blob into result.
+func (c *Client) getBlob(ctx context.Context, image string, digest string, result interface{}) error {
+	res := c.registry.R().
+		SetURL(fmt.Sprintf("%s/blobs/%s", c.registryImagePath(image), digest)).
+		Do(ctx)
+	if res.Err != nil {
+		return res.Err
+	}
+	// Blobs are served as application/octet-stream, so they are not decoded automatically.
+	return res.UnmarshalJson(result)
+}
+
+// registryImagePath is the repository path of an image as used by the registry API, e.g. devops-339608/services/campaign-service.
+func (c *Client) registryImagePath(image string) string {
+	return fmt.Sprintf("%s/%s/%s", c.ProjectID, c.Repository, image)
+}
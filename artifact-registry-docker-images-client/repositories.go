@@ -0,0 +1,225 @@
+package artifact_registry_docker_images_client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type ListRepositoriesResponse struct {
+	Repositories  []Repository `json:"repositories"`
+	NextPageToken string       `json:"nextPageToken"`
+}
+
+type Repository struct {
+	Name        string            `json:"name,omitempty"`
+	Format      string            `json:"format,omitempty"`
+	Mode        string            `json:"mode,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	// SizeBytes is an int64 encoded as a JSON string.
+	SizeBytes  string `json:"sizeBytes,omitempty"`
+	KmsKeyName string `json:"kmsKeyName,omitempty"`
+	CreateTime string `json:"createTime,omitempty"`
+	UpdateTime string `json:"updateTime,omitempty"`
+	// CleanupPolicies are keyed by policy ID.
+	CleanupPolicies     map[string]CleanupPolicy `json:"cleanupPolicies,omitempty"`
+	CleanupPolicyDryRun bool                     `json:"cleanupPolicyDryRun,omitempty"`
+	// RemoteRepositoryConfig is only set for repositories in REMOTE_REPOSITORY mode.
+	RemoteRepositoryConfig *RemoteRepositoryConfig `json:"remoteRepositoryConfig,omitempty"`
+	// VirtualRepositoryConfig is only set for repositories in VIRTUAL_REPOSITORY mode.
+	VirtualRepositoryConfig *VirtualRepositoryConfig `json:"virtualRepositoryConfig,omitempty"`
+}
+
+// CleanupPolicy either deletes or keeps the versions matching its condition, or keeps the most recent versions of
+// the matching packages.
+type CleanupPolicy struct {
+	ID                 string                           `json:"id"`
+	Action             string                           `json:"action"`
+	Condition          *CleanupPolicyCondition          `json:"condition,omitempty"`
+	MostRecentVersions *CleanupPolicyMostRecentVersions `json:"mostRecentVersions,omitempty"`
+}
+
+type CleanupPolicyCondition struct {
+	// TagState is one of TAGGED, UNTAGGED or ANY.
+	TagState            string   `json:"tagState,omitempty"`
+	TagPrefixes         []string `json:"tagPrefixes,omitempty"`
+	VersionNamePrefixes []string `json:"versionNamePrefixes,omitempty"`
+	PackageNamePrefixes []string `json:"packageNamePrefixes,omitempty"`
+	// OlderThan and NewerThan are durations in seconds, e.g. 2592000s.
+	OlderThan string `json:"olderThan,omitempty"`
+	NewerThan string `json:"newerThan,omitempty"`
+}
+
+type CleanupPolicyMostRecentVersions struct {
+	PackageNamePrefixes []string `json:"packageNamePrefixes,omitempty"`
+	KeepCount           int64    `json:"keepCount,omitempty"`
+}
+
+// RemoteRepositoryConfig configures the upstream a remote repository proxies. Only the upstream of the format of the
+// repository is set.
+type RemoteRepositoryConfig struct {
+	Description         string               `json:"description,omitempty"`
+	UpstreamCredentials *UpstreamCredentials `json:"upstreamCredentials,omitempty"`
+	DockerRepository    *RemoteUpstream      `json:"dockerRepository,omitempty"`
+	MavenRepository     *RemoteUpstream      `json:"mavenRepository,omitempty"`
+	NpmRepository       *RemoteUpstream      `json:"npmRepository,omitempty"`
+	PythonRepository    *RemoteUpstream      `json:"pythonRepository,omitempty"`
+}
+
+// RemoteUpstream is either a public repository preset, e.g. DOCKER_HUB or MAVEN_CENTRAL, or a custom repository.
+type RemoteUpstream struct {
+	PublicRepository string            `json:"publicRepository,omitempty"`
+	CustomRepository *CustomRepository `json:"customRepository,omitempty"`
+}
+
+type CustomRepository struct {
+	URI string `json:"uri"`
+}
+
+type UpstreamCredentials struct {
+	UsernamePasswordCredentials *UsernamePasswordCredentials `json:"usernamePasswordCredentials,omitempty"`
+}
+
+// UsernamePasswordCredentials authenticate with the upstream, the password is read from a Secret Manager secret
+// version, e.g. projects/devops-339608/secrets/docker-hub/versions/1.
+type UsernamePasswordCredentials struct {
+	Username              string `json:"username"`
+	PasswordSecretVersion string `json:"passwordSecretVersion"`
+}
+
+// Upstream returns the upstream of the repository format, e.g. DOCKER, or nil when it is not set.
+func (c *RemoteRepositoryConfig) Upstream(format string) *RemoteUpstream {
+	if field := c.upstreamField(format); field != nil {
+		return *field
+	}
+	return nil
+}
+
+// SetUpstream sets the upstream of the repository format, e.g. DOCKER. Formats without remote repositories are ignored.
+func (c *RemoteRepositoryConfig) SetUpstream(format string, upstream *RemoteUpstream) {
+	if field := c.upstreamField(format); field != nil {
+		*field = upstream
+	}
+}
+
+func (c *RemoteRepositoryConfig) upstreamField(format string) **RemoteUpstream {
+	switch format {
+	case "DOCKER":
+		return &c.DockerRepository
+	case "MAVEN":
+		return &c.MavenRepository
+	case "NPM":
+		return &c.NpmRepository
+	case "PYTHON":
+		return &c.PythonRepository
+	}
+	return nil
+}
+
+// VirtualRepositoryConfig lists the repositories a virtual repository serves artifacts from.
+type VirtualRepositoryConfig struct {
+	UpstreamPolicies []UpstreamPolicy `json:"upstreamPolicies,omitempty"`
+}
+
+// UpstreamPolicy references an upstream repository by its resource name, e.g.
+// projects/devops-339608/locations/europe/repositories/services. Upstreams with a higher priority are searched first.
+type UpstreamPolicy struct {
+	ID         string `json:"id"`
+	Repository string `json:"repository"`
+	Priority   int64  `json:"priority,omitempty"`
+}
+
+// ID returns the repository ID, e.g. services.
+func (r *Repository) ID() string {
+	return unescapeResourceID(r.Name)
+}
+
+// ListRepositories hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories/list
+// to list the repositories of the project in the given location.
+func (c *Client) ListRepositories(ctx context.Context, location string) ([]Repository, error) {
+	url := fmt.Sprintf("%s/repositories", c.locationPath(location))
+	return listAll(ctx, c, url, nil, 0, func(page *ListRepositoriesResponse) ([]Repository, string) {
+		return page.Repositories, page.NextPageToken
+	})
+}
+
+// GetRepository hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories/get
+// to get a repository of the project.
+func (c *Client) GetRepository(ctx context.Context, location string, repositoryID string) (*Repository, error) {
+	var repository Repository
+	res := c.R().SetURL(fmt.Sprintf("%s/repositories/%s", c.locationPath(location), repositoryID)).
+		SetSuccessResult(&repository).
+		Do(ctx)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	return &repository, nil
+}
+
+// GetRepositoryByName hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories/get
+// to get a repository by its resource name, e.g. projects/devops-339608/locations/europe/repositories/services.
+func (c *Client) GetRepositoryByName(ctx context.Context, name string) (*Repository, error) {
+	var repository Repository
+	res := c.R().SetURL(name).
+		SetSuccessResult(&repository).
+		Do(ctx)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	return &repository, nil
+}
+
+// CreateRepository hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories/create
+// to start creating a repository in the project.
+func (c *Client) CreateRepository(ctx context.Context, location string, repositoryID string, repository *Repository) (*Operation, error) {
+	var operation Operation
+	_, err := c.R().SetContext(ctx).
+		SetQueryParam("repositoryId", repositoryID).
+		SetBodyJsonMarshal(repository).
+		SetSuccessResult(&operation).
+		Post(fmt.Sprintf("%s/repositories", c.locationPath(location)))
+	if err != nil {
+		return nil, err
+	}
+	return &operation, nil
+}
+
+// UpdateRepository hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories/patch
+// to update the fields of repository listed in updateMask, e.g. cleanupPolicies.
+func (c *Client) UpdateRepository(ctx context.Context, repository *Repository, updateMask []string) (*Repository, error) {
+	var updated Repository
+	_, err := c.R().SetContext(ctx).
+		SetQueryParam("updateMask", strings.Join(updateMask, ",")).
+		SetBodyJsonMarshal(repository).
+		SetSuccessResult(&updated).
+		Patch(repository.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteRepository hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories/delete
+// to start deleting a repository and all of its contents.
+func (c *Client) DeleteRepository(ctx context.Context, name string) (*Operation, error) {
+	var operation Operation
+	_, err := c.R().SetContext(ctx).
+		SetSuccessResult(&operation).
+		Delete(name)
+	if err != nil {
+		return nil, err
+	}
+	return &operation, nil
+}
+
+// RepositoryName returns the resource name of a repository of the project, e.g.
+// projects/devops-339608/locations/europe/repositories/services.
+func (c *Client) RepositoryName(location string, repositoryID string) string {
+	return fmt.Sprintf("%s/repositories/%s", c.locationPath(location), repositoryID)
+}
+
+// locationPath is the resource name of a location of the project the client is configured for.
+func (c *Client) locationPath(location string) string {
+	return fmt.Sprintf("projects/%s/locations/%s", c.ProjectID, location)
+}
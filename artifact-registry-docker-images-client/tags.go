@@ -0,0 +1,36 @@
+package artifact_registry_docker_images_client
+
+import (
+	"context"
+	"fmt"
+)
+
+type ListTagsResponse struct {
+	Tags          []Tag  `json:"tags"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+type Tag struct {
+	Name string `json:"name"`
+	// Version is the resource name of the version the tag points to.
+	Version string `json:"version"`
+}
+
+// ID returns the tag ID, e.g. development-9681cde.
+func (t *Tag) ID() string {
+	return unescapeResourceID(t.Name)
+}
+
+// VersionID returns the ID of the version the tag points to, which is the digest for docker images.
+func (t *Tag) VersionID() string {
+	return unescapeResourceID(t.Version)
+}
+
+// ListTags hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.packages.tags/list
+// to list the tags of a package.
+func (c *Client) ListTags(ctx context.Context, packageID string) ([]Tag, error) {
+	url := fmt.Sprintf("%s/tags", c.packagePath(packageID))
+	return listAll(ctx, c, url, nil, 0, func(page *ListTagsResponse) ([]Tag, string) {
+		return page.Tags, page.NextPageToken
+	})
+}
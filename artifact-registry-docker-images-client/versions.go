@@ -0,0 +1,62 @@
+package artifact_registry_docker_images_client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+type ListVersionsResponse struct {
+	Versions      []Version `json:"versions"`
+	NextPageToken string    `json:"nextPageToken"`
+}
+
+type Version struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CreateTime  string `json:"createTime"`
+	UpdateTime  string `json:"updateTime"`
+	RelatedTags []Tag  `json:"relatedTags"`
+	// Metadata is the format specific metadata of the version, e.g. the image size and media type of docker images.
+	Metadata json.RawMessage `json:"metadata"`
+}
+
+// ID returns the version ID, which is the digest for docker images.
+func (v *Version) ID() string {
+	return unescapeResourceID(v.Name)
+}
+
+type ListVersionsOptions struct {
+	// OrderBy is the field to order the versions by, e.g. "create_time desc".
+	OrderBy string
+	// Limit is the maximum number of versions to return, 0 means all of them.
+	Limit int
+}
+
+// ListVersions hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.packages.versions/list
+// to list the versions of a package, including their related tags and metadata.
+func (c *Client) ListVersions(ctx context.Context, packageID string, options *ListVersionsOptions) ([]Version, error) {
+	versionsURL := fmt.Sprintf("%s/versions", c.packagePath(packageID))
+	params := map[string]string{"view": "FULL"}
+	if options.OrderBy != "" {
+		params["orderBy"] = options.OrderBy
+	}
+	return listAll(ctx, c, versionsURL, params, options.Limit, func(page *ListVersionsResponse) ([]Version, string) {
+		return page.Versions, page.NextPageToken
+	})
+}
+
+// DeleteVersion hits https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.packages.versions/delete
+// to delete a version and the tags pointing to it.
+func (c *Client) DeleteVersion(ctx context.Context, packageID string, versionID string) (*Operation, error) {
+	var operation Operation
+	_, err := c.R().SetContext(ctx).
+		SetSuccessResult(&operation).
+		SetQueryParam("force", "true").
+		Delete(fmt.Sprintf("%s/versions/%s", c.packagePath(packageID), url.PathEscape(versionID)))
+	if err != nil {
+		return nil, err
+	}
+	return &operation, nil
+}
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	cleanupPolicyActionDelete = "DELETE"
+	cleanupPolicyActionKeep   = "KEEP"
+
+	tagStateTagged   = "TAGGED"
+	tagStateUntagged = "UNTAGGED"
+	tagStateAny      = "ANY"
+)
+
+// cleanupPolicyDurationRegex matches the durations of the API, which are expressed in seconds, e.g. 2592000s.
+var cleanupPolicyDurationRegex = regexp.MustCompile(`^[0-9]+(\.[0-9]{1,9})?s$`)
+
+// CleanupPolicyModel describes a single cleanup policy, in the same shape as the API.
+type CleanupPolicyModel struct {
+	ID                 types.String                          `tfsdk:"id"`
+	Action             types.String                          `tfsdk:"action"`
+	Condition          *CleanupPolicyConditionModel          `tfsdk:"condition"`
+	MostRecentVersions *CleanupPolicyMostRecentVersionsModel `tfsdk:"most_recent_versions"`
+}
+
+type CleanupPolicyConditionModel struct {
+	TagState            types.String `tfsdk:"tag_state"`
+	TagPrefixes         types.List   `tfsdk:"tag_prefixes"`
+	VersionNamePrefixes types.List   `tfsdk:"version_name_prefixes"`
+	PackageNamePrefixes types.List   `tfsdk:"package_name_prefixes"`
+	OlderThan           types.String `tfsdk:"older_than"`
+	NewerThan           types.String `tfsdk:"newer_than"`
+}
+
+type CleanupPolicyMostRecentVersionsModel struct {
+	PackageNamePrefixes types.List  `tfsdk:"package_name_prefixes"`
+	KeepCount           types.Int64 `tfsdk:"keep_count"`
+}
+
+// validateCleanupPolicies checks the policies for the mistakes the API would only report on apply. Unknown values are
+// skipped, they are validated once known.
+func validateCleanupPolicies(attributePath path.Path, policies []CleanupPolicyModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	ids := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		id := policy.ID.ValueString()
+		if !policy.ID.IsUnknown() {
+			if ids[id] {
+				diags.AddAttributeError(attributePath, "duplicate cleanup policy id", fmt.Sprintf("The id %q is used by more than one cleanup policy.", id))
+			}
+			ids[id] = true
+		}
+
+		action := policy.Action.ValueString()
+		if !policy.Action.IsUnknown() && action != cleanupPolicyActionDelete && action != cleanupPolicyActionKeep {
+			diags.AddAttributeError(attributePath, "invalid cleanup policy action",
+				fmt.Sprintf("The action of cleanup policy %q must be %s or %s, got %q.", id, cleanupPolicyActionDelete, cleanupPolicyActionKeep, action))
+		}
+		if (policy.Condition == nil) == (policy.MostRecentVersions == nil) {
+			diags.AddAttributeError(attributePath, "invalid cleanup policy",
+				fmt.Sprintf("Cleanup policy %q must have exactly one of condition or most_recent_versions.", id))
+		}
+
+		if condition := policy.Condition; condition != nil {
+			tagState := condition.TagState.ValueString()
+			if !condition.TagState.IsNull() && !condition.TagState.IsUnknown() &&
+				tagState != tagStateTagged && tagState != tagStateUntagged && tagState != tagStateAny {
+				diags.AddAttributeError(attributePath, "invalid cleanup policy tag_state",
+					fmt.Sprintf("The tag_state of cleanup policy %q must be one of %s, %s or %s, got %q.", id, tagStateTagged, tagStateUntagged, tagStateAny, tagState))
+			}
+			if tagState == tagStateUntagged && len(condition.TagPrefixes.Elements()) > 0 {
+				diags.AddAttributeError(attributePath, "invalid cleanup policy condition",
+					fmt.Sprintf("Cleanup policy %q cannot match tag_prefixes of UNTAGGED versions.", id))
+			}
+			for name, duration := range map[string]types.String{"older_than": condition.OlderThan, "newer_than": condition.NewerThan} {
+				if !duration.IsNull() && !duration.IsUnknown() && !cleanupPolicyDurationRegex.MatchString(duration.ValueString()) {
+					diags.AddAttributeError(attributePath, fmt.Sprintf("invalid cleanup policy %s", name),
+						fmt.Sprintf("The %s of cleanup policy %q must be a duration in seconds, e.g. 2592000s, got %q.", name, id, duration.ValueString()))
+				}
+			}
+		}
+
+		if mostRecentVersions := policy.MostRecentVersions; mostRecentVersions != nil {
+			if !policy.Action.IsUnknown() && action != cleanupPolicyActionKeep {
+				diags.AddAttributeError(attributePath, "invalid cleanup policy",
+					fmt.Sprintf("Cleanup policy %q can only use most_recent_versions with the %s action.", id, cleanupPolicyActionKeep))
+			}
+			if !mostRecentVersions.KeepCount.IsNull() && !mostRecentVersions.KeepCount.IsUnknown() && mostRecentVersions.KeepCount.ValueInt64() < 1 {
+				diags.AddAttributeError(attributePath, "invalid cleanup policy keep_count",
+					fmt.Sprintf("The keep_count of cleanup policy %q must be at least 1.", id))
+			}
+		}
+	}
+	return diags
+}
+
+// cleanupPoliciesToAPI converts the policies to the map keyed by policy ID the API expects.
+func cleanupPoliciesToAPI(ctx context.Context, policies []CleanupPolicyModel) (map[string]artifactregistrydockerimagesclient.CleanupPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	apiPolicies := make(map[string]artifactregistrydockerimagesclient.CleanupPolicy, len(policies))
+	for _, policy := range policies {
+		apiPolicy := artifactregistrydockerimagesclient.CleanupPolicy{
+			ID:     policy.ID.ValueString(),
+			Action: policy.Action.ValueString(),
+		}
+		if condition := policy.Condition; condition != nil {
+			apiPolicy.Condition = &artifactregistrydockerimagesclient.CleanupPolicyCondition{
+				TagState:  condition.TagState.ValueString(),
+				OlderThan: condition.OlderThan.ValueString(),
+				NewerThan: condition.NewerThan.ValueString(),
+			}
+			diags.Append(condition.TagPrefixes.ElementsAs(ctx, &apiPolicy.Condition.TagPrefixes, false)...)
+			diags.Append(condition.VersionNamePrefixes.ElementsAs(ctx, &apiPolicy.Condition.VersionNamePrefixes, false)...)
+			diags.Append(condition.PackageNamePrefixes.ElementsAs(ctx, &apiPolicy.Condition.PackageNamePrefixes, false)...)
+		}
+		if mostRecentVersions := policy.MostRecentVersions; mostRecentVersions != nil {
+			apiPolicy.MostRecentVersions = &artifactregistrydockerimagesclient.CleanupPolicyMostRecentVersions{
+				KeepCount: mostRecentVersions.KeepCount.ValueInt64(),
+			}
+			diags.Append(mostRecentVersions.PackageNamePrefixes.ElementsAs(ctx, &apiPolicy.MostRecentVersions.PackageNamePrefixes, false)...)
+		}
+		apiPolicies[apiPolicy.ID] = apiPolicy
+	}
+	return apiPolicies, diags
+}
+
+// cleanupPoliciesFromAPI converts the policies of the API to models, ordered by policy ID.
+func cleanupPoliciesFromAPI(apiPolicies map[string]artifactregistrydockerimagesclient.CleanupPolicy) []CleanupPolicyModel {
+	policies := make([]CleanupPolicyModel, 0, len(apiPolicies))
+	for _, apiPolicy := range apiPolicies {
+		policy := CleanupPolicyModel{
+			ID:     types.StringValue(apiPolicy.ID),
+			Action: types.StringValue(apiPolicy.Action),
+		}
+		if condition := apiPolicy.Condition; condition != nil {
+			policy.Condition = &CleanupPolicyConditionModel{
+				TagState:            stringValueOrNull(condition.TagState),
+				TagPrefixes:         stringListValue(condition.TagPrefixes),
+				VersionNamePrefixes: stringListValue(condition.VersionNamePrefixes),
+				PackageNamePrefixes: stringListValue(condition.PackageNamePrefixes),
+				OlderThan:           stringValueOrNull(condition.OlderThan),
+				NewerThan:           stringValueOrNull(condition.NewerThan),
+			}
+		}
+		if mostRecentVersions := apiPolicy.MostRecentVersions; mostRecentVersions != nil {
+			policy.MostRecentVersions = &CleanupPolicyMostRecentVersionsModel{
+				PackageNamePrefixes: stringListValue(mostRecentVersions.PackageNamePrefixes),
+				KeepCount:           types.Int64Value(mostRecentVersions.KeepCount),
+			}
+		}
+		policies = append(policies, policy)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].ID.ValueString() < policies[j].ID.ValueString()
+	})
+	return policies
+}
+
+// stringValueOrNull maps the empty strings the API uses for unset fields to null.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// stringListValue maps the empty lists the API uses for unset fields to null.
+func stringListValue(values []string) types.List {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// cleanupCandidate is a version the cleanup policies are evaluated against.
+type cleanupCandidate struct {
+	Package    string
+	Version    string
+	Tags       []string
+	CreateTime time.Time
+}
+
+// cleanupDecision is the outcome of evaluating the cleanup policies against a candidate.
+type cleanupDecision struct {
+	cleanupCandidate
+	Delete bool
+	Reason string
+}
+
+// evaluateCleanupPolicies decides locally what the cleanup policies would do with the candidates at time now,
+// following the rules of Artifact Registry: versions matching a KEEP policy are kept, versions matching a DELETE
+// policy and no KEEP policy are deleted, and all other versions are kept.
+func evaluateCleanupPolicies(policies map[string]artifactregistrydockerimagesclient.CleanupPolicy, candidates []cleanupCandidate, now time.Time) ([]cleanupDecision, error) {
+	ids := make([]string, 0, len(policies))
+	for id := range policies {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	// The most recent versions policies need the candidates of each package ordered from newest to oldest.
+	packageRanks := make(map[string]map[string]int)
+	byPackage := make(map[string][]cleanupCandidate)
+	for _, candidate := range candidates {
+		byPackage[candidate.Package] = append(byPackage[candidate.Package], candidate)
+	}
+	for packageID, packageCandidates := range byPackage {
+		sort.SliceStable(packageCandidates, func(i, j int) bool {
+			return packageCandidates[i].CreateTime.After(packageCandidates[j].CreateTime)
+		})
+		packageRanks[packageID] = make(map[string]int, len(packageCandidates))
+		for rank, candidate := range packageCandidates {
+			packageRanks[packageID][candidate.Version] = rank
+		}
+	}
+
+	decisions := make([]cleanupDecision, 0, len(candidates))
+	for _, candidate := range candidates {
+		var keepPolicy, deletePolicy string
+		for _, id := range ids {
+			policy := policies[id]
+			matches, err := cleanupPolicyMatches(policy, candidate, packageRanks[candidate.Package][candidate.Version], now)
+			if err != nil {
+				return nil, fmt.Errorf("cleanup policy %q: %w", id, err)
+			}
+			if !matches {
+				continue
+			}
+			if policy.Action == cleanupPolicyActionKeep && keepPolicy == "" {
+				keepPolicy = id
+			}
+			if policy.Action == cleanupPolicyActionDelete && deletePolicy == "" {
+				deletePolicy = id
+			}
+		}
+
+		decision := cleanupDecision{cleanupCandidate: candidate}
+		switch {
+		case keepPolicy != "":
+			decision.Reason = fmt.Sprintf("kept by policy %q", keepPolicy)
+		case deletePolicy != "":
+			decision.Delete = true
+			decision.Reason = fmt.Sprintf("deleted by policy %q", deletePolicy)
+		default:
+			decision.Reason = "kept, no policy matched"
+		}
+		decisions = append(decisions, decision)
+	}
+	return decisions, nil
+}
+
+// cleanupPolicyMatches reports whether the policy applies to the candidate, whose rank is its position among the
+// versions of its package ordered from newest to oldest.
+func cleanupPolicyMatches(policy artifactregistrydockerimagesclient.CleanupPolicy, candidate cleanupCandidate, rank int, now time.Time) (bool, error) {
+	if mostRecentVersions := policy.MostRecentVersions; mostRecentVersions != nil {
+		if !hasAnyPrefix(candidate.Package, mostRecentVersions.PackageNamePrefixes) {
+			return false, nil
+		}
+		return int64(rank) < mostRecentVersions.KeepCount, nil
+	}
+
+	condition := policy.Condition
+	if condition == nil {
+		return false, nil
+	}
+	switch condition.TagState {
+	case tagStateTagged:
+		if len(candidate.Tags) == 0 {
+			return false, nil
+		}
+	case tagStateUntagged:
+		if len(candidate.Tags) > 0 {
+			return false, nil
+		}
+	}
+	if len(condition.TagPrefixes) > 0 {
+		matchesTag := false
+		for _, tag := range candidate.Tags {
+			matchesTag = matchesTag || hasAnyPrefix(tag, condition.TagPrefixes)
+		}
+		if !matchesTag {
+			return false, nil
+		}
+	}
+	if !hasAnyPrefix(candidate.Version, condition.VersionNamePrefixes) || !hasAnyPrefix(candidate.Package, condition.PackageNamePrefixes) {
+		return false, nil
+	}
+	if condition.OlderThan != "" {
+		olderThan, err := parseCleanupPolicyDuration(condition.OlderThan)
+		if err != nil {
+			return false, err
+		}
+		if !candidate.CreateTime.Before(now.Add(-olderThan)) {
+			return false, nil
+		}
+	}
+	if condition.NewerThan != "" {
+		newerThan, err := parseCleanupPolicyDuration(condition.NewerThan)
+		if err != nil {
+			return false, err
+		}
+		if !candidate.CreateTime.After(now.Add(-newerThan)) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// parseCleanupPolicyDuration parses a duration in seconds as used by the API, e.g. 2592000s.
+func parseCleanupPolicyDuration(duration string) (time.Duration, error) {
+	if !cleanupPolicyDurationRegex.MatchString(duration) {
+		return 0, fmt.Errorf("invalid duration %q, expected seconds such as 2592000s", duration)
+	}
+	seconds, err := strconv.ParseFloat(strings.TrimSuffix(duration, "s"), 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// hasAnyPrefix reports whether value starts with one of prefixes. No prefixes matches every value.
+func hasAnyPrefix(value string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
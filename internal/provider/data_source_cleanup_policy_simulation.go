@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CleanupPolicySimulationDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &CleanupPolicySimulationDataSource{}
+
+func NewCleanupPolicySimulationData() datasource.DataSource {
+	return &CleanupPolicySimulationDataSource{}
+}
+
+// CleanupPolicySimulationDataSource evaluates cleanup policies locally against the versions of the repository.
+type CleanupPolicySimulationDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// CleanupPolicySimulationDataSourceModel defines the data source model.
+type CleanupPolicySimulationDataSourceModel struct {
+	ID              types.String           `tfsdk:"id"`
+	EvaluationTime  types.String           `tfsdk:"evaluation_time"`
+	CleanupPolicies []CleanupPolicyModel   `tfsdk:"cleanup_policies"`
+	Versions        []CleanupDecisionModel `tfsdk:"versions"`
+	DeletedVersions []string               `tfsdk:"deleted_versions"`
+	KeptVersions    []string               `tfsdk:"kept_versions"`
+}
+
+// CleanupDecisionModel describes what the cleanup policies would do with a single version.
+type CleanupDecisionModel struct {
+	Package    types.String `tfsdk:"package"`
+	Version    types.String `tfsdk:"version"`
+	Tags       []string     `tfsdk:"tags"`
+	CreateTime types.String `tfsdk:"create_time"`
+	Action     types.String `tfsdk:"action"`
+	Reason     types.String `tfsdk:"reason"`
+}
+
+func (d *CleanupPolicySimulationDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_cleanup_policy_simulation"
+}
+
+func (d *CleanupPolicySimulationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CleanupPolicySimulationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source evaluates cleanup policies locally against the versions of the repository, " +
+			"and returns which versions the policies would delete or keep. Nothing is deleted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"evaluation_time": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The time to evaluate the older_than and newer_than conditions at, in RFC 3339 format. Defaults to now.",
+			},
+			"versions": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"package": schema.StringAttribute{
+							Computed: true,
+						},
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the version, which is the digest for docker images.",
+						},
+						"tags": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"create_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"action": schema.StringAttribute{
+							Computed:    true,
+							Description: "Either DELETE or KEEP.",
+						},
+						"reason": schema.StringAttribute{
+							Computed:    true,
+							Description: "Why the version would be deleted or kept, e.g. deleted by policy \"delete-untagged\".",
+						},
+					},
+				},
+			},
+			"deleted_versions": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The versions that would be deleted, as <package>@<version>.",
+			},
+			"kept_versions": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The versions that would be kept, as <package>@<version>.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			// TestCleanupPoliciesSchemaMatchesRepository keeps this block in line with the repository resource.
+			"cleanup_policies": schema.SetNestedBlock{
+				Description: "The cleanup policies to evaluate, in the same shape as the cleanup_policies of the artifactregistry_repository resource.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required: true,
+						},
+						"action": schema.StringAttribute{
+							Required:    true,
+							Description: "Either DELETE or KEEP.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"condition": schema.SingleNestedBlock{
+							Description: "The versions the policy applies to.",
+							Attributes: map[string]schema.Attribute{
+								"tag_state": schema.StringAttribute{
+									Optional:    true,
+									Description: "One of TAGGED, UNTAGGED or ANY.",
+								},
+								"tag_prefixes": schema.ListAttribute{
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+								"version_name_prefixes": schema.ListAttribute{
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+								"package_name_prefixes": schema.ListAttribute{
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+								"older_than": schema.StringAttribute{
+									Optional:    true,
+									Description: "Only match versions older than this duration in seconds, e.g. 2592000s.",
+								},
+								"newer_than": schema.StringAttribute{
+									Optional:    true,
+									Description: "Only match versions newer than this duration in seconds, e.g. 2592000s.",
+								},
+							},
+						},
+						"most_recent_versions": schema.SingleNestedBlock{
+							Description: "Keep the most recent versions of the matching packages. Only valid with the KEEP action.",
+							Attributes: map[string]schema.Attribute{
+								"package_name_prefixes": schema.ListAttribute{
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+								"keep_count": schema.Int64Attribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CleanupPolicySimulationDataSource) ValidateConfig(ctx context.Context, request datasource.ValidateConfigRequest, response *datasource.ValidateConfigResponse) {
+	var policies types.Set
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("cleanup_policies"), &policies)...)
+	if response.Diagnostics.HasError() || policies.IsUnknown() {
+		return
+	}
+	var policyModels []CleanupPolicyModel
+	response.Diagnostics.Append(policies.ElementsAs(ctx, &policyModels, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(validateCleanupPolicies(path.Root("cleanup_policies"), policyModels)...)
+}
+
+func (d *CleanupPolicySimulationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data CleanupPolicySimulationDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now().UTC()
+	if !data.EvaluationTime.IsNull() {
+		var err error
+		now, err = time.Parse(time.RFC3339, data.EvaluationTime.ValueString())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("evaluation_time"), "invalid evaluation_time", err.Error())
+			return
+		}
+	}
+	policies, diags := cleanupPoliciesToAPI(ctx, data.CleanupPolicies)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	candidates, err := d.listCandidates(ctx)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list versions", err.Error()))
+		return
+	}
+	decisions, err := evaluateCleanupPolicies(policies, candidates, now)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to evaluate cleanup policies", err.Error()))
+		return
+	}
+
+	client := d.client
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", client.ProjectID, client.Location, client.Repository))
+	data.EvaluationTime = types.StringValue(now.Format(time.RFC3339))
+	data.Versions = make([]CleanupDecisionModel, 0, len(decisions))
+	data.DeletedVersions = make([]string, 0)
+	data.KeptVersions = make([]string, 0)
+	for _, decision := range decisions {
+		action := cleanupPolicyActionKeep
+		versionName := fmt.Sprintf("%s@%s", decision.Package, decision.Version)
+		if decision.Delete {
+			action = cleanupPolicyActionDelete
+			data.DeletedVersions = append(data.DeletedVersions, versionName)
+		} else {
+			data.KeptVersions = append(data.KeptVersions, versionName)
+		}
+		data.Versions = append(data.Versions, CleanupDecisionModel{
+			Package:    types.StringValue(decision.Package),
+			Version:    types.StringValue(decision.Version),
+			Tags:       decision.Tags,
+			CreateTime: types.StringValue(decision.CreateTime.Format(time.RFC3339)),
+			Action:     types.StringValue(action),
+			Reason:     types.StringValue(decision.Reason),
+		})
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// listCandidates lists every version of every package in the repository.
+func (d *CleanupPolicySimulationDataSource) listCandidates(ctx context.Context) ([]cleanupCandidate, error) {
+	packages, err := d.client.ListPackages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []cleanupCandidate
+	for _, registryPackage := range packages {
+		versions, err := d.client.ListVersions(ctx, registryPackage.ID(), &artifactregistrydockerimagesclient.ListVersionsOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, version := range versions {
+			createTime, err := time.Parse(time.RFC3339, version.CreateTime)
+			if err != nil {
+				return nil, err
+			}
+			tags := make([]string, 0, len(version.RelatedTags))
+			for _, tag := range version.RelatedTags {
+				tags = append(tags, tag.ID())
+			}
+			candidates = append(candidates, cleanupCandidate{
+				Package:    registryPackage.ID(),
+				Version:    version.ID(),
+				Tags:       tags,
+				CreateTime: createTime,
+			})
+		}
+	}
+	return candidates, nil
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCleanupPolicySimulationDataSource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+data "artifactregistry_cleanup_policy_simulation" "test" {
+	cleanup_policies {
+		id = "delete-untagged"
+		action = "DELETE"
+		condition {
+			tag_state = "UNTAGGED"
+		}
+	}
+	cleanup_policies {
+		id = "keep-development"
+		action = "KEEP"
+		condition {
+			tag_prefixes = ["development"]
+		}
+	}
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.artifactregistry_cleanup_policy_simulation.test", "evaluation_time"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.artifactregistry_cleanup_policy_simulation.test", "versions.*", map[string]string{
+						"package": "campaign-service",
+						"action":  "KEEP",
+						"reason":  `kept by policy "keep-development"`,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestEvaluateCleanupPolicies(t *testing.T) {
+	now := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	candidates := []cleanupCandidate{
+		{Package: "campaign-service", Version: "sha256:1", Tags: []string{"development-1"}, CreateTime: now.Add(-40 * day)},
+		{Package: "campaign-service", Version: "sha256:2", CreateTime: now.Add(-35 * day)},
+		{Package: "campaign-service", Version: "sha256:3", CreateTime: now.Add(-2 * day)},
+		{Package: "campaign-service", Version: "sha256:4", Tags: []string{"production"}, CreateTime: now.Add(-1 * day)},
+		{Package: "user-service", Version: "sha256:5", CreateTime: now.Add(-50 * day)},
+	}
+	policies := map[string]artifactregistrydockerimagesclient.CleanupPolicy{
+		"delete-old": {
+			ID:        "delete-old",
+			Action:    cleanupPolicyActionDelete,
+			Condition: &artifactregistrydockerimagesclient.CleanupPolicyCondition{TagState: tagStateAny, OlderThan: "2592000s"},
+		},
+		"keep-production": {
+			ID:        "keep-production",
+			Action:    cleanupPolicyActionKeep,
+			Condition: &artifactregistrydockerimagesclient.CleanupPolicyCondition{TagState: tagStateTagged, TagPrefixes: []string{"prod"}},
+		},
+		"keep-recent-users": {
+			ID:                 "keep-recent-users",
+			Action:             cleanupPolicyActionKeep,
+			MostRecentVersions: &artifactregistrydockerimagesclient.CleanupPolicyMostRecentVersions{PackageNamePrefixes: []string{"user-"}, KeepCount: 1},
+		},
+	}
+
+	decisions, err := evaluateCleanupPolicies(policies, candidates, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"sha256:1": `deleted by policy "delete-old"`,
+		"sha256:2": `deleted by policy "delete-old"`,
+		"sha256:3": "kept, no policy matched",
+		"sha256:4": `kept by policy "keep-production"`,
+		"sha256:5": `kept by policy "keep-recent-users"`,
+	}
+	if len(decisions) != len(expected) {
+		t.Fatalf("expected %d decisions, got %d", len(expected), len(decisions))
+	}
+	for _, decision := range decisions {
+		if decision.Reason != expected[decision.Version] {
+			t.Errorf("%s: expected %q, got %q", decision.Version, expected[decision.Version], decision.Reason)
+		}
+		if decision.Delete != (decision.Reason[:7] == "deleted") {
+			t.Errorf("%s: delete is %t but reason is %q", decision.Version, decision.Delete, decision.Reason)
+		}
+	}
+}
+
+func TestParseCleanupPolicyDuration(t *testing.T) {
+	duration, err := parseCleanupPolicyDuration("86400.5s")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if duration != 24*time.Hour+500*time.Millisecond {
+		t.Errorf("unexpected duration %s", duration)
+	}
+	if _, err := parseCleanupPolicyDuration("1d"); err == nil {
+		t.Error("expected 1d to be invalid")
+	}
+}
+
+func TestCleanupPoliciesSchemaMatchesRepository(t *testing.T) {
+	ctx := context.Background()
+	var resourceSchema frameworkresource.SchemaResponse
+	(&RepositoryResource{}).Schema(ctx, frameworkresource.SchemaRequest{}, &resourceSchema)
+	var dataSourceSchema datasource.SchemaResponse
+	(&CleanupPolicySimulationDataSource{}).Schema(ctx, datasource.SchemaRequest{}, &dataSourceSchema)
+
+	resourceType := resourceSchema.Schema.Blocks["cleanup_policies"].Type().TerraformType(ctx)
+	dataSourceType := dataSourceSchema.Schema.Blocks["cleanup_policies"].Type().TerraformType(ctx)
+	if !resourceType.Equal(dataSourceType) {
+		t.Errorf("expected the simulated cleanup_policies to match the repository, got %s and %s", dataSourceType, resourceType)
+	}
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strings"
+)
+
+const defaultPlatform = "linux/amd64"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DockerImageConfigDataSource{}
+
+func NewDockerImageConfigData() datasource.DataSource {
+	return &DockerImageConfigDataSource{}
+}
+
+// DockerImageConfigDataSource defines the data source implementation.
+type DockerImageConfigDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// DockerImageConfigDataSourceModel defines the data source model.
+type DockerImageConfigDataSourceModel struct {
+	ID           types.String      `tfsdk:"id"`
+	Image        types.String      `tfsdk:"image"`
+	Reference    types.String      `tfsdk:"reference"`
+	Platform     types.String      `tfsdk:"platform"`
+	Digest       types.String      `tfsdk:"digest"`
+	Labels       map[string]string `tfsdk:"labels"`
+	Entrypoint   []string          `tfsdk:"entrypoint"`
+	Cmd          []string          `tfsdk:"cmd"`
+	Env          map[string]string `tfsdk:"env"`
+	ExposedPorts []string          `tfsdk:"exposed_ports"`
+	User         types.String      `tfsdk:"user"`
+	WorkingDir   types.String      `tfsdk:"working_dir"`
+	Created      types.String      `tfsdk:"created"`
+}
+
+func (d *DockerImageConfigDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_docker_image_config"
+}
+
+func (d *DockerImageConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DockerImageConfigDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the config (labels, entrypoint, environment, ...) of an image.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"image": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the image in the repository, e.g. campaign-service.",
+			},
+			"reference": schema.StringAttribute{
+				Required:    true,
+				Description: "The tag or digest of the image.",
+			},
+			"platform": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The platform to read the config of when the reference is a multi-arch image. Defaults to " + defaultPlatform + ".",
+			},
+			"digest": schema.StringAttribute{
+				Computed:    true,
+				Description: "The digest of the image manifest the config belongs to.",
+			},
+			"labels": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"entrypoint": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"cmd": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"env": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The environment variables of the image, keyed by name.",
+			},
+			"exposed_ports": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The exposed ports of the image, e.g. 8080/tcp.",
+			},
+			"user": schema.StringAttribute{
+				Computed: true,
+			},
+			"working_dir": schema.StringAttribute{
+				Computed: true,
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the image was created, in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+func (d *DockerImageConfigDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data DockerImageConfigDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if data.Platform.IsNull() {
+		data.Platform = types.StringValue(defaultPlatform)
+	}
+
+	image := data.Image.ValueString()
+	manifest, err := d.client.GetManifest(ctx, image, data.Reference.ValueString())
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to get manifest", err.Error()))
+		return
+	}
+	manifest, err = d.client.GetPlatformManifest(ctx, image, manifest, data.Platform.ValueString())
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to get platform manifest", err.Error()))
+		return
+	}
+	config, err := d.client.GetImageConfig(ctx, image, manifest)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to get image config", err.Error()))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s@%s", image, manifest.Digest))
+	data.Digest = types.StringValue(manifest.Digest)
+	data.Labels = config.Config.Labels
+	data.Entrypoint = config.Config.Entrypoint
+	data.Cmd = config.Config.Cmd
+	data.Env = make(map[string]string, len(config.Config.Env))
+	for _, variable := range config.Config.Env {
+		name, value, _ := strings.Cut(variable, "=")
+		data.Env[name] = value
+	}
+	data.ExposedPorts = make([]string, 0, len(config.Config.ExposedPorts))
+	for port := range config.Config.ExposedPorts {
+		data.ExposedPorts = append(data.ExposedPorts, port)
+	}
+	sort.Strings(data.ExposedPorts)
+	data.User = types.StringValue(config.Config.User)
+	data.WorkingDir = types.StringValue(config.Config.WorkingDir)
+	data.Created = types.StringValue(config.Created)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDockerImageConfigDataSource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+data "artifactregistry_docker_image_config" "test" {
+	image = "campaign-service"
+	reference = "development-9681cde"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.artifactregistry_docker_image_config.test", "platform", "linux/amd64"),
+					resource.TestCheckResourceAttrSet("data.artifactregistry_docker_image_config.test", "digest"),
+					resource.TestCheckResourceAttrSet("data.artifactregistry_docker_image_config.test", "created"),
+				),
+			},
+		},
+	})
+}
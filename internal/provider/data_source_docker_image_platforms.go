@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DockerImagePlatformsDataSource{}
+
+func NewDockerImagePlatformsData() datasource.DataSource {
+	return &DockerImagePlatformsDataSource{}
+}
+
+// DockerImagePlatformsDataSource defines the data source implementation.
+type DockerImagePlatformsDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// DockerImagePlatformsDataSourceModel defines the data source model.
+type DockerImagePlatformsDataSourceModel struct {
+	ID              types.String               `tfsdk:"id"`
+	Image           types.String               `tfsdk:"image"`
+	Reference       types.String               `tfsdk:"reference"`
+	Digest          types.String               `tfsdk:"digest"`
+	MediaType       types.String               `tfsdk:"media_type"`
+	Platforms       []DockerImagePlatformModel `tfsdk:"platforms"`
+	PlatformDigests map[string]string          `tfsdk:"platform_digests"`
+}
+
+// DockerImagePlatformModel describes a single platform manifest of an image.
+type DockerImagePlatformModel struct {
+	OS           types.String `tfsdk:"os"`
+	Architecture types.String `tfsdk:"architecture"`
+	Variant      types.String `tfsdk:"variant"`
+	Digest       types.String `tfsdk:"digest"`
+}
+
+func (d *DockerImagePlatformsDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_docker_image_platforms"
+}
+
+func (d *DockerImagePlatformsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DockerImagePlatformsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the per-platform manifests of a (multi-arch) image.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"image": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the image in the repository, e.g. campaign-service.",
+			},
+			"reference": schema.StringAttribute{
+				Required:    true,
+				Description: "The tag or digest of the image.",
+			},
+			"digest": schema.StringAttribute{
+				Computed:    true,
+				Description: "The digest of the manifest the reference resolves to.",
+			},
+			"media_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The media type of the manifest the reference resolves to.",
+			},
+			"platforms": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The platforms of the image. Single-platform images yield a single entry.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"os": schema.StringAttribute{
+							Computed: true,
+						},
+						"architecture": schema.StringAttribute{
+							Computed: true,
+						},
+						"variant": schema.StringAttribute{
+							Computed: true,
+						},
+						"digest": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"platform_digests": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The platform manifest digests keyed by os/architecture[/variant], e.g. linux/arm64.",
+			},
+		},
+	}
+}
+
+func (d *DockerImagePlatformsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data DockerImagePlatformsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	image := data.Image.ValueString()
+	manifest, err := d.client.GetManifest(ctx, image, data.Reference.ValueString())
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to get manifest", err.Error()))
+		return
+	}
+
+	var platforms []artifactregistrydockerimagesclient.Descriptor
+	if manifest.IsIndex() {
+		for _, descriptor := range manifest.Manifests {
+			// Build attestations are stored next to the platform manifests, but do not describe a platform.
+			if descriptor.Platform == nil || descriptor.Annotations["vnd.docker.reference.type"] == "attestation-manifest" {
+				continue
+			}
+			platforms = append(platforms, descriptor)
+		}
+	} else {
+		config, err := d.client.GetImageConfig(ctx, image, manifest)
+		if err != nil {
+			response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to get image config", err.Error()))
+			return
+		}
+		platforms = append(platforms, artifactregistrydockerimagesclient.Descriptor{
+			MediaType: manifest.MediaType,
+			Digest:    manifest.Digest,
+			Platform: &artifactregistrydockerimagesclient.Platform{
+				Architecture: config.Architecture,
+				OS:           config.OS,
+				Variant:      config.Variant,
+			},
+		})
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s@%s", image, manifest.Digest))
+	data.Digest = types.StringValue(manifest.Digest)
+	data.MediaType = types.StringValue(manifest.MediaType)
+	data.Platforms = make([]DockerImagePlatformModel, 0, len(platforms))
+	data.PlatformDigests = make(map[string]string, len(platforms))
+	for _, descriptor := range platforms {
+		data.Platforms = append(data.Platforms, DockerImagePlatformModel{
+			OS:           types.StringValue(descriptor.Platform.OS),
+			Architecture: types.StringValue(descriptor.Platform.Architecture),
+			Variant:      types.StringValue(descriptor.Platform.Variant),
+			Digest:       types.StringValue(descriptor.Digest),
+		})
+		data.PlatformDigests[descriptor.Platform.String()] = descriptor.Digest
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FilesDataSource{}
+
+func NewFilesData() datasource.DataSource {
+	return &FilesDataSource{}
+}
+
+// FilesDataSource defines the data source implementation.
+type FilesDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// FilesDataSourceModel defines the data source model.
+type FilesDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Package     types.String `tfsdk:"package"`
+	Version     types.String `tfsdk:"version"`
+	DownloadDir types.String `tfsdk:"download_dir"`
+	Files       []FileModel  `tfsdk:"files"`
+}
+
+// FileModel describes a single file of a version.
+type FileModel struct {
+	Name       types.String `tfsdk:"name"`
+	Filename   types.String `tfsdk:"filename"`
+	SizeBytes  types.Int64  `tfsdk:"size_bytes"`
+	SHA256     types.String `tfsdk:"sha256"`
+	MD5        types.String `tfsdk:"md5"`
+	Owner      types.String `tfsdk:"owner"`
+	CreateTime types.String `tfsdk:"create_time"`
+	UpdateTime types.String `tfsdk:"update_time"`
+	LocalPath  types.String `tfsdk:"local_path"`
+}
+
+func (d *FilesDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_files"
+}
+
+func (d *FilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FilesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the files of a version of a package with their hashes, " +
+			"and optionally downloads them after verifying their hashes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"package": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the package, e.g. charts.",
+			},
+			"version": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the version, e.g. 1.4.0.",
+			},
+			"download_dir": schema.StringAttribute{
+				Optional: true,
+				Description: "The local directory to download the files into, named by their filename. A file is only " +
+					"written once its content matches the SHA256 hash, or the MD5 hash when no SHA256 hash is reported.",
+			},
+			"files": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The resource name of the file.",
+						},
+						"filename": schema.StringAttribute{
+							Computed:    true,
+							Description: "The last segment of the file ID, e.g. campaign-service-1.4.0.tgz.",
+						},
+						"size_bytes": schema.Int64Attribute{
+							Computed: true,
+						},
+						"sha256": schema.StringAttribute{
+							Computed:    true,
+							Description: "The hex encoded SHA256 hash of the file.",
+						},
+						"md5": schema.StringAttribute{
+							Computed:    true,
+							Description: "The hex encoded MD5 hash of the file.",
+						},
+						"owner": schema.StringAttribute{
+							Computed:    true,
+							Description: "The resource name of the version the file belongs to.",
+						},
+						"create_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"update_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"local_path": schema.StringAttribute{
+							Computed:    true,
+							Description: "The path of the downloaded file. Only set when download_dir is set.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FilesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data FilesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	files, err := d.client.ListFiles(ctx, data.Package.ValueString(), data.Version.ValueString())
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list files", err.Error()))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s@%s", data.Package.ValueString(), data.Version.ValueString()))
+	data.Files = make([]FileModel, 0, len(files))
+	for _, file := range files {
+		sizeBytes, err := parseInt64String(file.SizeBytes)
+		if err != nil {
+			response.Diagnostics.Append(diag.NewErrorDiagnostic("invalid file size", err.Error()))
+			return
+		}
+		filename := fileBaseName(file.ID())
+		localPath := types.StringNull()
+		if !data.DownloadDir.IsNull() {
+			downloadPath := filepath.Join(data.DownloadDir.ValueString(), filename)
+			if err := d.download(ctx, &file, downloadPath); err != nil {
+				response.Diagnostics.Append(diag.NewErrorDiagnostic(fmt.Sprintf("failed to download %s", file.Name), err.Error()))
+				return
+			}
+			localPath = types.StringValue(downloadPath)
+		}
+		data.Files = append(data.Files, FileModel{
+			Name:       types.StringValue(file.Name),
+			Filename:   types.StringValue(filename),
+			SizeBytes:  types.Int64Value(sizeBytes),
+			SHA256:     stringValueOrNull(file.SHA256()),
+			MD5:        stringValueOrNull(file.MD5()),
+			Owner:      types.StringValue(file.Owner),
+			CreateTime: types.StringValue(file.CreateTime),
+			UpdateTime: types.StringValue(file.UpdateTime),
+			LocalPath:  localPath,
+		})
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// download writes the file to a temporary file next to downloadPath, and only moves it to downloadPath once its hash
+// is verified, so a failed download never leaves a partial or corrupted file behind.
+func (d *FilesDataSource) download(ctx context.Context, file *artifactregistrydockerimagesclient.File, downloadPath string) error {
+	if err := os.MkdirAll(filepath.Dir(downloadPath), 0o755); err != nil {
+		return err
+	}
+	temporary, err := os.CreateTemp(filepath.Dir(downloadPath), filepath.Base(downloadPath)+".*.download")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temporary.Name())
+	defer temporary.Close()
+
+	sha256Hash, md5Hash := sha256.New(), md5.New()
+	if err := d.client.DownloadFile(ctx, file.ID(), io.MultiWriter(temporary, sha256Hash, md5Hash)); err != nil {
+		return err
+	}
+	if err := verifyDownloadedFile(file, hex.EncodeToString(sha256Hash.Sum(nil)), hex.EncodeToString(md5Hash.Sum(nil))); err != nil {
+		return err
+	}
+	if err := temporary.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temporary.Name(), downloadPath)
+}
+
+// verifyDownloadedFile checks the hashes of the downloaded content against the SHA256 hash of the file, or its MD5
+// hash when the API reported no SHA256 hash.
+func verifyDownloadedFile(file *artifactregistrydockerimagesclient.File, downloadedSHA256 string, downloadedMD5 string) error {
+	if expected := file.SHA256(); expected != "" {
+		if expected != downloadedSHA256 {
+			return fmt.Errorf("the SHA256 hash of the download is %s, expected %s", downloadedSHA256, expected)
+		}
+		return nil
+	}
+	if expected := file.MD5(); expected != "" {
+		if expected != downloadedMD5 {
+			return fmt.Errorf("the MD5 hash of the download is %s, expected %s", downloadedMD5, expected)
+		}
+		return nil
+	}
+	return fmt.Errorf("the API reported no hash to verify the download against")
+}
+
+// fileBaseName returns the last segment of a file ID, e.g. campaign-service-1.4.0.tgz for the generic artifact
+// charts:1.4.0:campaign-service-1.4.0.tgz or com/fourthfloor/campaign-client/1.1.0/campaign-client-1.1.0.jar.
+func fileBaseName(fileID string) string {
+	return path.Base(fileID[strings.LastIndex(fileID, ":")+1:])
+}
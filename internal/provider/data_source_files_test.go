@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"testing"
+
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFilesDataSource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "generic"
+}
+data "artifactregistry_files" "test" {
+	package = "charts"
+	version = "1.0.0"
+	download_dir = "` + t.TempDir() + `"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.artifactregistry_files.test", "files.0.sha256"),
+					resource.TestCheckResourceAttrSet("data.artifactregistry_files.test", "files.0.local_path"),
+				),
+			},
+		},
+	})
+}
+
+func TestVerifyDownloadedFile(t *testing.T) {
+	// The SHA256 and MD5 hashes of "hello", base64 encoded as reported by the API.
+	sha256Hash := artifactregistrydockerimagesclient.Hash{Type: "SHA256", Value: "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ="}
+	md5Hash := artifactregistrydockerimagesclient.Hash{Type: "MD5", Value: "XUFAKrxLKna5cZ2REBfFkg=="}
+	helloSHA256 := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	helloMD5 := "5d41402abc4b2a76b9719d911017c592"
+
+	testCases := map[string]struct {
+		hashes []artifactregistrydockerimagesclient.Hash
+		sha256 string
+		md5    string
+		valid  bool
+	}{
+		"matching sha256":             {hashes: []artifactregistrydockerimagesclient.Hash{md5Hash, sha256Hash}, sha256: helloSHA256, md5: helloMD5, valid: true},
+		"mismatching sha256":          {hashes: []artifactregistrydockerimagesclient.Hash{sha256Hash}, sha256: helloMD5, md5: helloMD5},
+		"sha256 takes precedence":     {hashes: []artifactregistrydockerimagesclient.Hash{md5Hash, sha256Hash}, sha256: "other", md5: helloMD5},
+		"matching md5 without sha":    {hashes: []artifactregistrydockerimagesclient.Hash{md5Hash}, sha256: "other", md5: helloMD5, valid: true},
+		"mismatching md5 without sha": {hashes: []artifactregistrydockerimagesclient.Hash{md5Hash}, sha256: helloSHA256, md5: "other"},
+		"no hashes":                   {sha256: helloSHA256, md5: helloMD5},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			file := &artifactregistrydockerimagesclient.File{Hashes: testCase.hashes}
+			if err := verifyDownloadedFile(file, testCase.sha256, testCase.md5); (err == nil) != testCase.valid {
+				t.Errorf("expected valid to be %t, got %v", testCase.valid, err)
+			}
+		})
+	}
+}
+
+func TestFileBaseName(t *testing.T) {
+	testCases := map[string]string{
+		"charts:1.4.0:campaign-service-1.4.0.tgz":                         "campaign-service-1.4.0.tgz",
+		"com/fourthfloor/campaign-client/1.1.0/campaign-client-1.1.0.jar": "campaign-client-1.1.0.jar",
+		"campaign_client-1.1.0-py3-none-any.whl":                          "campaign_client-1.1.0-py3-none-any.whl",
+	}
+	for fileID, expected := range testCases {
+		if baseName := fileBaseName(fileID); baseName != expected {
+			t.Errorf("expected %s to have the base name %s, got %s", fileID, expected, baseName)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GoModulesDataSource{}
+
+func NewGoModulesData() datasource.DataSource {
+	return &GoModulesDataSource{}
+}
+
+// GoModulesDataSource defines the data source implementation.
+type GoModulesDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// GoModulesDataSourceModel defines the data source model.
+type GoModulesDataSourceModel struct {
+	ID             types.String      `tfsdk:"id"`
+	ModulePath     types.String      `tfsdk:"module_path"`
+	GoModules      []GoModuleModel   `tfsdk:"go_modules"`
+	LatestVersions map[string]string `tfsdk:"latest_versions"`
+}
+
+// GoModuleModel describes a single version of a go module.
+type GoModuleModel struct {
+	ModulePath types.String `tfsdk:"module_path"`
+	Version    types.String `tfsdk:"version"`
+	CreateTime types.String `tfsdk:"create_time"`
+	UpdateTime types.String `tfsdk:"update_time"`
+}
+
+func (d *GoModulesDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_go_modules"
+}
+
+func (d *GoModulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GoModulesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the versions of the go modules in a go repository.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"module_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the versions of this module, e.g. github.com/Fourth-Floor-Creative/deploy-tool/v2.",
+			},
+			"go_modules": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"module_path": schema.StringAttribute{
+							Computed: true,
+						},
+						"version": schema.StringAttribute{
+							Computed: true,
+						},
+						"create_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"update_time": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"latest_versions": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The version go would resolve @latest to, keyed by module path. Only versions matching the major " +
+					"version suffix of the module path are considered, e.g. v2.x.y for a module path ending in /v2. Releases " +
+					"are preferred over +incompatible releases, pre-releases and pseudo-versions, in that order.",
+			},
+		},
+	}
+}
+
+func (d *GoModulesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data GoModulesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.client
+	packages, err := client.ListPackages(ctx)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list packages", err.Error()))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", client.ProjectID, client.Location, client.Repository))
+	data.GoModules = []GoModuleModel{}
+	data.LatestVersions = make(map[string]string)
+	for _, goModule := range packages {
+		// The package ID of a go module is its module path.
+		modulePath := goModule.ID()
+		if !data.ModulePath.IsNull() && modulePath != data.ModulePath.ValueString() {
+			continue
+		}
+		versions, err := client.ListVersions(ctx, modulePath, &artifactregistrydockerimagesclient.ListVersionsOptions{})
+		if err != nil {
+			response.Diagnostics.Append(diag.NewErrorDiagnostic(fmt.Sprintf("failed to list versions of %s", modulePath), err.Error()))
+			return
+		}
+		versionIDs := make([]string, 0, len(versions))
+		for _, version := range versions {
+			versionIDs = append(versionIDs, version.ID())
+			data.GoModules = append(data.GoModules, GoModuleModel{
+				ModulePath: types.StringValue(modulePath),
+				Version:    types.StringValue(version.ID()),
+				CreateTime: types.StringValue(version.CreateTime),
+				UpdateTime: types.StringValue(version.UpdateTime),
+			})
+		}
+		if latest := latestGoModuleVersion(modulePath, versionIDs); latest != "" {
+			data.LatestVersions[modulePath] = latest
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// latestGoModuleVersion returns the version the go command would resolve @latest to, or an empty string when none of
+// the versions is valid for the module path under semantic import versioning.
+func latestGoModuleVersion(modulePath string, versions []string) string {
+	latest, latestRank := "", -1
+	for _, version := range versions {
+		// The go command only serves canonical versions, e.g. v2.0.0 rather than v2.0.
+		if module.CanonicalVersion(version) != version || module.Check(modulePath, version) != nil {
+			continue
+		}
+		rank := goModuleVersionRank(version)
+		if rank > latestRank || (rank == latestRank && semver.Compare(version, latest) > 0) {
+			latest, latestRank = version, rank
+		}
+	}
+	return latest
+}
+
+// goModuleVersionRank orders the kinds of versions by how much @latest prefers them.
+func goModuleVersionRank(version string) int {
+	switch {
+	case module.IsPseudoVersion(version):
+		return 0
+	case semver.Prerelease(version) != "":
+		return 1
+	case strings.HasSuffix(version, "+incompatible"):
+		return 2
+	}
+	return 3
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGoModulesDataSource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "go"
+}
+data "artifactregistry_go_modules" "test" {
+	module_path = "github.com/Fourth-Floor-Creative/deploy-tool"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.artifactregistry_go_modules.test", "go_modules.0.module_path", "github.com/Fourth-Floor-Creative/deploy-tool"),
+					resource.TestCheckResourceAttrSet("data.artifactregistry_go_modules.test", "latest_versions.github.com/Fourth-Floor-Creative/deploy-tool"),
+				),
+			},
+		},
+	})
+}
+
+func TestLatestGoModuleVersion(t *testing.T) {
+	testCases := map[string]struct {
+		modulePath string
+		versions   []string
+		latest     string
+	}{
+		"highest release": {
+			modulePath: "example.com/tool",
+			versions:   []string{"v1.2.0", "v1.10.0", "v1.9.3"},
+			latest:     "v1.10.0",
+		},
+		"release over pre-release and pseudo-version": {
+			modulePath: "example.com/tool",
+			versions:   []string{"v1.2.0", "v1.3.0-rc.1", "v1.3.1-0.20230501120000-abcdefabcdef"},
+			latest:     "v1.2.0",
+		},
+		"pre-release without release": {
+			modulePath: "example.com/tool",
+			versions:   []string{"v0.1.0-alpha", "v0.1.0-beta", "v0.0.0-20230501120000-abcdefabcdef"},
+			latest:     "v0.1.0-beta",
+		},
+		"major version suffix": {
+			modulePath: "example.com/tool/v2",
+			versions:   []string{"v1.9.0", "v2.1.0", "v3.0.0", "v2.0.4"},
+			latest:     "v2.1.0",
+		},
+		"incompatible below compatible release": {
+			modulePath: "example.com/tool",
+			versions:   []string{"v1.4.0", "v2.0.0+incompatible", "v2.0.0"},
+			latest:     "v1.4.0",
+		},
+		"incompatible without compatible release": {
+			modulePath: "example.com/tool",
+			versions:   []string{"v2.0.0+incompatible", "v3.1.0+incompatible"},
+			latest:     "v3.1.0+incompatible",
+		},
+		"no valid version": {
+			modulePath: "example.com/tool/v2",
+			versions:   []string{"v1.0.0", "latest", "v2.0"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if latest := latestGoModuleVersion(testCase.modulePath, testCase.versions); latest != testCase.latest {
+				t.Errorf("expected %q, got %q", testCase.latest, latest)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/mod/semver"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HelmChartsDataSource{}
+
+func NewHelmChartsData() datasource.DataSource {
+	return &HelmChartsDataSource{}
+}
+
+// HelmChartsDataSource defines the data source implementation.
+type HelmChartsDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// HelmChartsDataSourceModel defines the data source model.
+type HelmChartsDataSourceModel struct {
+	ID                 types.String              `tfsdk:"id"`
+	ChartName          types.String              `tfsdk:"chart_name"`
+	IncludePrereleases types.Bool                `tfsdk:"include_prereleases"`
+	HelmCharts         []HelmChartModel          `tfsdk:"helm_charts"`
+	LatestVersions     map[string]HelmChartModel `tfsdk:"latest_versions"`
+}
+
+// HelmChartModel describes a single version of a chart.
+type HelmChartModel struct {
+	Name        types.String `tfsdk:"name"`
+	Version     types.String `tfsdk:"version"`
+	AppVersion  types.String `tfsdk:"app_version"`
+	Description types.String `tfsdk:"description"`
+	URI         types.String `tfsdk:"uri"`
+	Digest      types.String `tfsdk:"digest"`
+	Tags        []string     `tfsdk:"tags"`
+	UploadTime  types.String `tfsdk:"upload_time"`
+}
+
+// helmChart is a chart version together with the image it was pushed as.
+type helmChart struct {
+	image    artifactregistrydockerimagesclient.DockerImage
+	metadata *artifactregistrydockerimagesclient.HelmChartMetadata
+}
+
+func (d *HelmChartsDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_helm_charts"
+}
+
+func (d *HelmChartsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *HelmChartsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	helmChartAttributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the chart, as declared in its Chart.yaml.",
+		},
+		"version": schema.StringAttribute{
+			Computed: true,
+		},
+		"app_version": schema.StringAttribute{
+			Computed:    true,
+			Description: "The version of the app the chart deploys, if declared in its Chart.yaml.",
+		},
+		"description": schema.StringAttribute{
+			Computed: true,
+		},
+		"uri": schema.StringAttribute{
+			Computed:    true,
+			Description: "The URI of the chart, prefix it with oci:// to pass it to helm.",
+		},
+		"digest": schema.StringAttribute{
+			Computed: true,
+		},
+		"tags": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"upload_time": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the versions of the helm charts pushed as OCI artifacts to a docker " +
+			"repository. Charts are told apart from container images by the media type of their config.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"chart_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the versions of this chart, e.g. campaign-service.",
+			},
+			"include_prereleases": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether pre-releases, e.g. 1.2.0-rc.1, can be selected as latest_versions.",
+			},
+			"helm_charts": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: helmChartAttributes,
+				},
+			},
+			"latest_versions": schema.MapNestedAttribute{
+				Computed: true,
+				Description: "The highest version of every chart according to semantic versioning, keyed by chart name. " +
+					"Versions that are not semantic versions are never selected.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: helmChartAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *HelmChartsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data HelmChartsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.client
+	images, err := client.ListImages(ctx)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list images", err.Error()))
+		return
+	}
+
+	var charts []helmChart
+	for _, image := range images {
+		// helm push always creates an OCI image manifest, so indexes and docker manifests are skipped without fetching them.
+		if image.MediaType != artifactregistrydockerimagesclient.MediaTypeOCIManifest {
+			continue
+		}
+		imageName, digest := image.ImageAndDigest()
+		manifest, err := client.GetManifest(ctx, imageName, digest)
+		if err != nil {
+			response.Diagnostics.Append(diag.NewErrorDiagnostic(fmt.Sprintf("failed to get manifest of %s", image.Uri), err.Error()))
+			return
+		}
+		if !manifest.IsHelmChart() {
+			continue
+		}
+		metadata, err := client.GetHelmChartMetadata(ctx, imageName, manifest)
+		if err != nil {
+			response.Diagnostics.Append(diag.NewErrorDiagnostic(fmt.Sprintf("failed to get chart metadata of %s", image.Uri), err.Error()))
+			return
+		}
+		if data.ChartName.IsNull() || metadata.Name == data.ChartName.ValueString() {
+			charts = append(charts, helmChart{image: image, metadata: metadata})
+		}
+	}
+	latestVersions := latestHelmChartVersions(charts, data.IncludePrereleases.ValueBool())
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", client.ProjectID, client.Location, client.Repository))
+	data.HelmCharts = make([]HelmChartModel, 0, len(charts))
+	for _, chart := range charts {
+		data.HelmCharts = append(data.HelmCharts, helmChartToModel(chart))
+	}
+	data.LatestVersions = make(map[string]HelmChartModel, len(latestVersions))
+	for chartName, chart := range latestVersions {
+		data.LatestVersions[chartName] = helmChartToModel(chart)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// latestHelmChartVersions returns the highest semantic version of every chart, keyed by chart name. Pre-releases are
+// skipped unless includePrereleases is set, charts without a selectable version are left out.
+func latestHelmChartVersions(charts []helmChart, includePrereleases bool) map[string]helmChart {
+	latestVersions := make(map[string]helmChart)
+	for _, chart := range charts {
+		version := helmChartSemver(chart.metadata.Version)
+		if !semver.IsValid(version) || (semver.Prerelease(version) != "" && !includePrereleases) {
+			continue
+		}
+		latest, ok := latestVersions[chart.metadata.Name]
+		if !ok || semver.Compare(version, helmChartSemver(latest.metadata.Version)) > 0 {
+			latestVersions[chart.metadata.Name] = chart
+		}
+	}
+	return latestVersions
+}
+
+// helmChartSemver adds the v prefix golang.org/x/mod/semver requires, which chart versions usually omit.
+func helmChartSemver(version string) string {
+	if strings.HasPrefix(version, "v") {
+		return version
+	}
+	return "v" + version
+}
+
+func helmChartToModel(chart helmChart) HelmChartModel {
+	_, digest := chart.image.ImageAndDigest()
+	return HelmChartModel{
+		Name:        types.StringValue(chart.metadata.Name),
+		Version:     types.StringValue(chart.metadata.Version),
+		AppVersion:  stringValueOrNull(chart.metadata.AppVersion),
+		Description: stringValueOrNull(chart.metadata.Description),
+		URI:         types.StringValue(chart.image.Uri),
+		Digest:      types.StringValue(digest),
+		Tags:        chart.image.Tags,
+		UploadTime:  types.StringValue(chart.image.UploadTime),
+	}
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"testing"
+
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHelmChartsDataSource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "charts"
+}
+data "artifactregistry_helm_charts" "test" {
+	chart_name = "campaign-service"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.artifactregistry_helm_charts.test", "helm_charts.0.name", "campaign-service"),
+					resource.TestCheckResourceAttrSet("data.artifactregistry_helm_charts.test", "latest_versions.campaign-service.version"),
+				),
+			},
+		},
+	})
+}
+
+func TestLatestHelmChartVersions(t *testing.T) {
+	chart := func(name string, version string) helmChart {
+		return helmChart{metadata: &artifactregistrydockerimagesclient.HelmChartMetadata{Name: name, Version: version}}
+	}
+	charts := []helmChart{
+		chart("campaign-service", "1.2.0"),
+		chart("campaign-service", "1.10.0"),
+		chart("campaign-service", "1.11.0-rc.1"),
+		chart("campaign-service", "latest"),
+		chart("ingress", "v0.3.1"),
+		chart("ingress", "0.3.0"),
+		chart("cron", "2.0.0-beta.1"),
+	}
+
+	latest := latestHelmChartVersions(charts, false)
+	expected := map[string]string{"campaign-service": "1.10.0", "ingress": "v0.3.1"}
+	if len(latest) != len(expected) {
+		t.Errorf("expected %d charts, got %d", len(expected), len(latest))
+	}
+	for name, version := range expected {
+		if latest[name].metadata == nil || latest[name].metadata.Version != version {
+			t.Errorf("expected %s to be the latest version of %s", version, name)
+		}
+	}
+
+	latest = latestHelmChartVersions(charts, true)
+	if latest["campaign-service"].metadata.Version != "1.11.0-rc.1" || latest["cron"].metadata.Version != "2.0.0-beta.1" {
+		t.Error("expected pre-releases to be selected when included")
+	}
+}
@@ -29,9 +29,10 @@ type ArtifactRegistryImagesDataSource struct {
 
 // ArtifactRegistryImagesDataSourceModel defines the data source model.
 type ArtifactRegistryImagesDataSourceModel struct {
-	Images       CustomImageValue `tfsdk:"images"`
-	LatestImages CustomImageValue `tfsdk:"latest_images"`
-	ID           types.String     `tfsdk:"id"`
+	Images        CustomImageValue `tfsdk:"images"`
+	LatestImages  CustomImageValue `tfsdk:"latest_images"`
+	IncludeLayers types.Bool       `tfsdk:"include_layers"`
+	ID            types.String     `tfsdk:"id"`
 }
 
 func (a *ArtifactRegistryImagesDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
@@ -44,17 +45,36 @@ type CustomImageValueType struct {
 
 func (civt CustomImageValueType) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"name":             types.StringType,
-		"uri":              types.StringType,
-		"tags":             types.ListType{ElemType: types.StringType},
-		"image_size_bytes": types.StringType,
-		"upload_time":      types.StringType,
-		"media_type":       types.StringType,
-		"build_time":       types.StringType,
-		"update_time":      types.StringType,
+		"name":                  types.StringType,
+		"uri":                   types.StringType,
+		"tags":                  types.ListType{ElemType: types.StringType},
+		"image_size_bytes":      types.StringType,
+		"upload_time":           types.StringType,
+		"media_type":            types.StringType,
+		"build_time":            types.StringType,
+		"update_time":           types.StringType,
+		"layers":                types.ListType{ElemType: types.ObjectType{AttrTypes: imageLayerAttributeTypes}},
+		"compressed_size_bytes": types.Int64Type,
+		"layer_count":           types.Int64Type,
 	}
 }
 
+// imageLayerAttributeTypes are the attribute types of a single entry of an image's layers.
+var imageLayerAttributeTypes = map[string]attr.Type{
+	"digest":     types.StringType,
+	"media_type": types.StringType,
+	"size_bytes": types.Int64Type,
+}
+
+// imageLayerObjectType is the tftypes counterpart of imageLayerAttributeTypes.
+var imageLayerObjectType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"digest":     tftypes.String,
+		"media_type": tftypes.String,
+		"size_bytes": tftypes.Number,
+	},
+}
+
 type ImageListType struct {
 	types.ListType
 }
@@ -98,6 +118,15 @@ type CustomImageValue struct {
 	MediaType            string   `tfsdk:"media_type"`
 	BuildTime            string   `tfsdk:"build_time"`
 	UpdateTime           string   `tfsdk:"update_time"`
+	// Layers is nil when the layers were not fetched, or the image is an index without layers of its own.
+	Layers []ImageLayer `tfsdk:"layers"`
+}
+
+// ImageLayer describes a single (compressed) layer of an image manifest.
+type ImageLayer struct {
+	Digest    string `tfsdk:"digest"`
+	MediaType string `tfsdk:"media_type"`
+	SizeBytes int64  `tfsdk:"size_bytes"`
 }
 
 func (v CustomImageValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
@@ -106,6 +135,26 @@ func (v CustomImageValue) ToTerraformValue(ctx context.Context) (tftypes.Value,
 		tags = append(tags, tftypes.NewValue(tftypes.String, tag))
 	}
 
+	layersType := tftypes.List{ElementType: imageLayerObjectType}
+	layers := tftypes.NewValue(layersType, nil)
+	compressedSizeBytes := tftypes.NewValue(tftypes.Number, nil)
+	layerCount := tftypes.NewValue(tftypes.Number, nil)
+	if v.Layers != nil {
+		layerValues := make([]tftypes.Value, 0, len(v.Layers))
+		var totalSizeBytes int64
+		for _, layer := range v.Layers {
+			layerValues = append(layerValues, tftypes.NewValue(imageLayerObjectType, map[string]tftypes.Value{
+				"digest":     tftypes.NewValue(tftypes.String, layer.Digest),
+				"media_type": tftypes.NewValue(tftypes.String, layer.MediaType),
+				"size_bytes": tftypes.NewValue(tftypes.Number, layer.SizeBytes),
+			}))
+			totalSizeBytes += layer.SizeBytes
+		}
+		layers = tftypes.NewValue(layersType, layerValues)
+		compressedSizeBytes = tftypes.NewValue(tftypes.Number, totalSizeBytes)
+		layerCount = tftypes.NewValue(tftypes.Number, int64(len(v.Layers)))
+	}
+
 	result := tftypes.NewValue(tftypes.Object{
 		AttributeTypes: map[string]tftypes.Type{
 			"name":                   tftypes.String,
@@ -117,6 +166,9 @@ func (v CustomImageValue) ToTerraformValue(ctx context.Context) (tftypes.Value,
 			"media_type":             tftypes.String,
 			"build_time":             tftypes.String,
 			"update_time":            tftypes.String,
+			"layers":                 layersType,
+			"compressed_size_bytes":  tftypes.Number,
+			"layer_count":            tftypes.Number,
 		},
 	}, map[string]tftypes.Value{
 		"name":                   tftypes.NewValue(tftypes.String, v.Name),
@@ -128,6 +180,9 @@ func (v CustomImageValue) ToTerraformValue(ctx context.Context) (tftypes.Value,
 		"media_type":             tftypes.NewValue(tftypes.String, v.MediaType),
 		"build_time":             tftypes.NewValue(tftypes.String, v.BuildTime),
 		"update_time":            tftypes.NewValue(tftypes.String, v.UpdateTime),
+		"layers":                 layers,
+		"compressed_size_bytes":  compressedSizeBytes,
+		"layer_count":            layerCount,
 	})
 
 	return result, nil
@@ -164,6 +219,10 @@ func (a *ArtifactRegistryImagesDataSource) Schema(ctx context.Context, request d
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"include_layers": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to fetch the manifest of every image to populate layers, compressed_size_bytes and layer_count.",
+			},
 			"latest_images": schema.MapNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -195,6 +254,9 @@ func (a *ArtifactRegistryImagesDataSource) Schema(ctx context.Context, request d
 						"update_time": schema.StringAttribute{
 							Computed: true,
 						},
+						"layers":                imageLayersAttribute,
+						"compressed_size_bytes": compressedSizeBytesAttribute,
+						"layer_count":           layerCountAttribute,
 					},
 				},
 				Computed: true,
@@ -217,6 +279,11 @@ func (a *ArtifactRegistryImagesDataSource) Schema(ctx context.Context, request d
 								"media_type":       types.StringType,
 								"build_time":       types.StringType,
 								"update_time":      types.StringType,
+								"layers": types.ListType{
+									ElemType: types.ObjectType{AttrTypes: imageLayerAttributeTypes},
+								},
+								"compressed_size_bytes": types.Int64Type,
+								"layer_count":           types.Int64Type,
 							},
 						},
 					},
@@ -251,6 +318,9 @@ func (a *ArtifactRegistryImagesDataSource) Schema(ctx context.Context, request d
 						"update_time": schema.StringAttribute{
 							Computed: true,
 						},
+						"layers":                imageLayersAttribute,
+						"compressed_size_bytes": compressedSizeBytesAttribute,
+						"layer_count":           layerCountAttribute,
 					},
 				},
 			},
@@ -258,15 +328,63 @@ func (a *ArtifactRegistryImagesDataSource) Schema(ctx context.Context, request d
 	}
 }
 
+// imageLayersAttribute, compressedSizeBytesAttribute and layerCountAttribute are shared by the images and
+// latest_images nested objects.
+var (
+	imageLayersAttribute = schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "The layers of the image manifest. Only set when include_layers is true and the image is not an index.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"digest": schema.StringAttribute{
+					Computed: true,
+				},
+				"media_type": schema.StringAttribute{
+					Computed: true,
+				},
+				"size_bytes": schema.Int64Attribute{
+					Computed:    true,
+					Description: "The compressed size of the layer.",
+				},
+			},
+		},
+	}
+	compressedSizeBytesAttribute = schema.Int64Attribute{
+		Computed:    true,
+		Description: "The sum of the compressed sizes of the layers.",
+	}
+	layerCountAttribute = schema.Int64Attribute{
+		Computed: true,
+	}
+)
+
 func (a *ArtifactRegistryImagesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	client := a.client
+	var includeLayers types.Bool
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("include_layers"), &includeLayers)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	images, err := client.ListImages(ctx)
 	if err != nil {
 		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list images", err.Error()))
 		return
 	}
 
-	latestImages, err := mapLatestImages(images)
+	layers := make(map[string][]ImageLayer)
+	if includeLayers.ValueBool() {
+		for _, image := range images {
+			imageLayers, err := a.getLayers(ctx, image)
+			if err != nil {
+				response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to get image layers", err.Error()))
+				return
+			}
+			layers[image.Uri] = imageLayers
+		}
+	}
+
+	latestImages, err := mapLatestImages(images, layers)
 	if err != nil {
 		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to map latest images", err.Error()))
 		return
@@ -285,6 +403,7 @@ func (a *ArtifactRegistryImagesDataSource) Read(ctx context.Context, request dat
 			MediaType:      image.MediaType,
 			BuildTime:      image.BuildTime,
 			UpdateTime:     image.UpdateTime,
+			Layers:         layers[image.Uri],
 		}
 
 		imagesList = append(imagesList, imageValue)
@@ -308,7 +427,28 @@ func (a *ArtifactRegistryImagesDataSource) Read(ctx context.Context, request dat
 	}
 }
 
-func mapLatestImages(images []artifactregistrydockerimagesclient.DockerImage) (map[string]attr.Value, error) {
+// getLayers reads the layers of an image from its manifest. Indexes have no layers of their own and yield nil.
+func (a *ArtifactRegistryImagesDataSource) getLayers(ctx context.Context, image artifactregistrydockerimagesclient.DockerImage) ([]ImageLayer, error) {
+	imageName, digest := image.ImageAndDigest()
+	manifest, err := a.client.GetManifest(ctx, imageName, digest)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.IsIndex() {
+		return nil, nil
+	}
+	layers := make([]ImageLayer, 0, len(manifest.Layers))
+	for _, layer := range manifest.Layers {
+		layers = append(layers, ImageLayer{
+			Digest:    layer.Digest,
+			MediaType: layer.MediaType,
+			SizeBytes: layer.Size,
+		})
+	}
+	return layers, nil
+}
+
+func mapLatestImages(images []artifactregistrydockerimagesclient.DockerImage, layers map[string][]ImageLayer) (map[string]attr.Value, error) {
 	latestImages := make(map[string]artifactregistrydockerimagesclient.DockerImage)
 	for _, image := range images {
 		serviceName := strings.Replace(image.Name, "projects/devops-339608/locations/europe/repositories/services/dockerImages/", "", -1)
@@ -354,6 +494,7 @@ func mapLatestImages(images []artifactregistrydockerimagesclient.DockerImage) (m
 			MediaType:            image.MediaType,
 			BuildTime:            image.BuildTime,
 			UpdateTime:           image.UpdateTime,
+			Layers:               layers[image.Uri],
 		}
 		convertedMap[serviceName] = imageValue
 	}
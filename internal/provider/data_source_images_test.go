@@ -14,6 +14,16 @@ provider "artifactregistry" {
 	repository = "services"
 }
 data "artifactregistry_artifact_registry_images" "test" {}
+`
+	layersConfig := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+data "artifactregistry_artifact_registry_images" "test" {
+	include_layers = true
+}
 `
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -22,13 +32,13 @@ data "artifactregistry_artifact_registry_images" "test" {}
 			{
 				Config: config,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.artifactregistry_artifact_registry_images.test", "images.0.%", "9"),
+					resource.TestCheckResourceAttr("data.artifactregistry_artifact_registry_images.test", "images.0.%", "12"),
 				),
 			},
 			{
 				Config: config,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.artifactregistry_artifact_registry_images.test", "latest_images.campaign-service.%", "9"),
+					resource.TestCheckResourceAttr("data.artifactregistry_artifact_registry_images.test", "latest_images.campaign-service.%", "12"),
 				),
 			},
 			{
@@ -37,6 +47,14 @@ data "artifactregistry_artifact_registry_images" "test" {}
 					resource.TestCheckResourceAttr("data.artifactregistry_artifact_registry_images.test", "latest_images.campaign-service.development_tagged_uri", "europe-docker.pkg.dev/devops-339608/services/campaign-service:development-9681cde"),
 				),
 			},
+			{
+				Config: layersConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.artifactregistry_artifact_registry_images.test", "latest_images.campaign-service.layer_count"),
+					resource.TestCheckResourceAttrSet("data.artifactregistry_artifact_registry_images.test", "latest_images.campaign-service.compressed_size_bytes"),
+					resource.TestCheckResourceAttrSet("data.artifactregistry_artifact_registry_images.test", "latest_images.campaign-service.layers.0.digest"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LocationsDataSource{}
+
+func NewLocationsData() datasource.DataSource {
+	return &LocationsDataSource{}
+}
+
+// LocationsDataSource defines the data source implementation.
+type LocationsDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// LocationsDataSourceModel defines the data source model.
+type LocationsDataSourceModel struct {
+	ID          types.String    `tfsdk:"id"`
+	LocationIDs []string        `tfsdk:"location_ids"`
+	Locations   []LocationModel `tfsdk:"locations"`
+}
+
+// LocationModel describes a single Artifact Registry location.
+type LocationModel struct {
+	Name               types.String `tfsdk:"name"`
+	LocationID         types.String `tfsdk:"location_id"`
+	DisplayName        types.String `tfsdk:"display_name"`
+	DockerRegistryHost types.String `tfsdk:"docker_registry_host"`
+}
+
+func (d *LocationsDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_locations"
+}
+
+func (d *LocationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LocationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the Artifact Registry locations available to the project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"location_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the locations, e.g. europe or us-central1.",
+			},
+			"locations": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The resource name of the location.",
+						},
+						"location_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"display_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"docker_registry_host": schema.StringAttribute{
+							Computed:    true,
+							Description: "The host of the Docker registry in the location, e.g. europe-docker.pkg.dev.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LocationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	locations, err := d.client.ListLocations(ctx)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list locations", err.Error()))
+		return
+	}
+
+	data := LocationsDataSourceModel{
+		ID:          types.StringValue(d.client.ProjectID),
+		LocationIDs: make([]string, 0, len(locations)),
+		Locations:   make([]LocationModel, 0, len(locations)),
+	}
+	for _, location := range locations {
+		data.LocationIDs = append(data.LocationIDs, location.LocationID)
+		data.Locations = append(data.Locations, LocationModel{
+			Name:               types.StringValue(location.Name),
+			LocationID:         types.StringValue(location.LocationID),
+			DisplayName:        types.StringValue(location.DisplayName),
+			DockerRegistryHost: types.StringValue(artifactregistrydockerimagesclient.DockerRegistryHost(location.LocationID)),
+		})
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLocationsDataSource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+data "artifactregistry_locations" "test" {}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.artifactregistry_locations.test", "location_ids.*", "europe"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.artifactregistry_locations.test", "locations.*", map[string]string{
+						"location_id":          "europe",
+						"docker_registry_host": "europe-docker.pkg.dev",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MavenArtifactsDataSource{}
+
+func NewMavenArtifactsData() datasource.DataSource {
+	return &MavenArtifactsDataSource{}
+}
+
+// MavenArtifactsDataSource defines the data source implementation.
+type MavenArtifactsDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// MavenArtifactsDataSourceModel defines the data source model.
+type MavenArtifactsDataSourceModel struct {
+	ID             types.String                  `tfsdk:"id"`
+	MavenArtifacts []MavenArtifactModel          `tfsdk:"maven_artifacts"`
+	LatestReleases map[string]MavenArtifactModel `tfsdk:"latest_releases"`
+}
+
+// MavenArtifactModel describes a single version of a maven artifact.
+type MavenArtifactModel struct {
+	Name       types.String `tfsdk:"name"`
+	GroupID    types.String `tfsdk:"group_id"`
+	ArtifactID types.String `tfsdk:"artifact_id"`
+	Version    types.String `tfsdk:"version"`
+	PomURI     types.String `tfsdk:"pom_uri"`
+	CreateTime types.String `tfsdk:"create_time"`
+	UpdateTime types.String `tfsdk:"update_time"`
+}
+
+func (d *MavenArtifactsDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_maven_artifacts"
+}
+
+func (d *MavenArtifactsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MavenArtifactsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	mavenArtifactAttributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The resource name of the maven artifact.",
+		},
+		"group_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"artifact_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"version": schema.StringAttribute{
+			Computed: true,
+		},
+		"pom_uri": schema.StringAttribute{
+			Computed:    true,
+			Description: "The URI of the pom file of the version.",
+		},
+		"create_time": schema.StringAttribute{
+			Computed: true,
+		},
+		"update_time": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the maven artifacts in a maven repository.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"maven_artifacts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Every version of every maven artifact in the repository.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: mavenArtifactAttributes,
+				},
+			},
+			"latest_releases": schema.MapNestedAttribute{
+				Computed: true,
+				Description: "The highest release, i.e. not a -SNAPSHOT version, of every artifact in Maven's version order, " +
+					"keyed by group ID and artifact ID, e.g. com.fourthfloor:campaign-client.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: mavenArtifactAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *MavenArtifactsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := d.client
+	artifacts, err := client.ListMavenArtifacts(ctx)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list maven artifacts", err.Error()))
+		return
+	}
+	latestReleases, err := latestMavenReleases(artifacts)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to map latest releases", err.Error()))
+		return
+	}
+
+	data := MavenArtifactsDataSourceModel{
+		ID:             types.StringValue(fmt.Sprintf("%s/%s/%s", client.ProjectID, client.Location, client.Repository)),
+		MavenArtifacts: make([]MavenArtifactModel, 0, len(artifacts)),
+		LatestReleases: make(map[string]MavenArtifactModel, len(latestReleases)),
+	}
+	for _, artifact := range artifacts {
+		data.MavenArtifacts = append(data.MavenArtifacts, mavenArtifactToModel(artifact))
+	}
+	for coordinates, artifact := range latestReleases {
+		data.LatestReleases[coordinates] = mavenArtifactToModel(artifact)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// latestMavenReleases returns the highest release of every artifact in Maven's version order, keyed by its
+// coordinates. Versions Maven considers equal, e.g. 1.0 and 1.0.0, are told apart by their create time. Snapshots are
+// skipped, artifacts without any release are left out.
+func latestMavenReleases(artifacts []artifactregistrydockerimagesclient.MavenArtifact) (map[string]artifactregistrydockerimagesclient.MavenArtifact, error) {
+	latestReleases := make(map[string]artifactregistrydockerimagesclient.MavenArtifact)
+	createTimes := make(map[string]time.Time)
+	for _, artifact := range artifacts {
+		if artifact.IsSnapshot() {
+			continue
+		}
+		createTime, err := time.Parse(time.RFC3339, artifact.CreateTime)
+		if err != nil {
+			return nil, err
+		}
+		coordinates := artifact.Coordinates()
+		latest, ok := latestReleases[coordinates]
+		if ok {
+			c := compareMavenVersions(artifact.Version, latest.Version)
+			if c < 0 || (c == 0 && !createTime.After(createTimes[coordinates])) {
+				continue
+			}
+		}
+		latestReleases[coordinates] = artifact
+		createTimes[coordinates] = createTime
+	}
+	return latestReleases, nil
+}
+
+func mavenArtifactToModel(artifact artifactregistrydockerimagesclient.MavenArtifact) MavenArtifactModel {
+	return MavenArtifactModel{
+		Name:       types.StringValue(artifact.Name),
+		GroupID:    types.StringValue(artifact.GroupID),
+		ArtifactID: types.StringValue(artifact.ArtifactID),
+		Version:    types.StringValue(artifact.Version),
+		PomURI:     types.StringValue(artifact.PomURI),
+		CreateTime: types.StringValue(artifact.CreateTime),
+		UpdateTime: types.StringValue(artifact.UpdateTime),
+	}
+}
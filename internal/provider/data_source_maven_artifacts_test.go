@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMavenArtifactsDataSource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "maven"
+}
+data "artifactregistry_maven_artifacts" "test" {}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.artifactregistry_maven_artifacts.test", "maven_artifacts.0.pom_uri"),
+					resource.TestCheckResourceAttrSet("data.artifactregistry_maven_artifacts.test", "maven_artifacts.0.group_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestLatestMavenReleases(t *testing.T) {
+	artifacts := []artifactregistrydockerimagesclient.MavenArtifact{
+		{GroupID: "com.fourthfloor", ArtifactID: "campaign-client", Version: "1.0.0", CreateTime: "2023-04-01T10:00:00.123456Z"},
+		{GroupID: "com.fourthfloor", ArtifactID: "campaign-client", Version: "1.1.0", CreateTime: "2023-04-10T10:00:00Z"},
+		{GroupID: "com.fourthfloor", ArtifactID: "campaign-client", Version: "2.0.0", CreateTime: "2023-04-11T10:00:00Z"},
+		{GroupID: "com.fourthfloor", ArtifactID: "campaign-client", Version: "2.0", CreateTime: "2023-04-12T10:00:00Z"},
+		// A patch of the previous major version, published after 2.0.0.
+		{GroupID: "com.fourthfloor", ArtifactID: "campaign-client", Version: "1.10.1", CreateTime: "2023-04-15T10:00:00Z"},
+		{GroupID: "com.fourthfloor", ArtifactID: "campaign-client", Version: "1.2.0-SNAPSHOT", CreateTime: "2023-04-20T10:00:00Z"},
+		{GroupID: "com.fourthfloor", ArtifactID: "events", Version: "0.1.0-SNAPSHOT", CreateTime: "2023-04-20T10:00:00Z"},
+	}
+	latestReleases, err := latestMavenReleases(artifacts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(latestReleases) != 1 {
+		t.Fatalf("expected only campaign-client to have a release, got %v", latestReleases)
+	}
+	// 2.0 and 2.0.0 are the same version in Maven's order, the one created last wins.
+	if version := latestReleases["com.fourthfloor:campaign-client"].Version; version != "2.0" {
+		t.Errorf("expected 2.0 to be the latest release, got %s", version)
+	}
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NpmPackagesDataSource{}
+
+func NewNpmPackagesData() datasource.DataSource {
+	return &NpmPackagesDataSource{}
+}
+
+// NpmPackagesDataSource defines the data source implementation.
+type NpmPackagesDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// NpmPackagesDataSourceModel defines the data source model.
+type NpmPackagesDataSourceModel struct {
+	ID          types.String                 `tfsdk:"id"`
+	PackageName types.String                 `tfsdk:"package_name"`
+	NpmPackages []NpmPackageModel            `tfsdk:"npm_packages"`
+	DistTags    map[string]map[string]string `tfsdk:"dist_tags"`
+}
+
+// NpmPackageModel describes a single version of an npm package.
+type NpmPackageModel struct {
+	Name        types.String `tfsdk:"name"`
+	PackageName types.String `tfsdk:"package_name"`
+	Version     types.String `tfsdk:"version"`
+	Tags        []string     `tfsdk:"tags"`
+	CreateTime  types.String `tfsdk:"create_time"`
+	UpdateTime  types.String `tfsdk:"update_time"`
+}
+
+func (d *NpmPackagesDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_npm_packages"
+}
+
+func (d *NpmPackagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NpmPackagesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the versions of the npm packages in an npm repository with their dist-tags.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"package_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the versions of this package, e.g. @fourthfloor/web.",
+			},
+			"npm_packages": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The resource name of the package version.",
+						},
+						"package_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"version": schema.StringAttribute{
+							Computed: true,
+						},
+						"tags": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The dist-tags pointing to the version, e.g. latest.",
+						},
+						"create_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"update_time": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dist_tags": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.MapType{ElemType: types.StringType},
+				Description: "The versions the dist-tags point to, keyed by package name and tag, e.g. dist_tags[\"@fourthfloor/web\"][\"latest\"].",
+			},
+		},
+	}
+}
+
+func (d *NpmPackagesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data NpmPackagesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.client
+	npmPackages, err := client.ListNpmPackages(ctx)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list npm packages", err.Error()))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", client.ProjectID, client.Location, client.Repository))
+	data.NpmPackages = make([]NpmPackageModel, 0, len(npmPackages))
+	data.DistTags = make(map[string]map[string]string)
+	for _, npmPackage := range npmPackages {
+		if !data.PackageName.IsNull() && npmPackage.PackageName != data.PackageName.ValueString() {
+			continue
+		}
+		tags := npmPackage.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		data.NpmPackages = append(data.NpmPackages, NpmPackageModel{
+			Name:        types.StringValue(npmPackage.Name),
+			PackageName: types.StringValue(npmPackage.PackageName),
+			Version:     types.StringValue(npmPackage.Version),
+			Tags:        tags,
+			CreateTime:  types.StringValue(npmPackage.CreateTime),
+			UpdateTime:  types.StringValue(npmPackage.UpdateTime),
+		})
+		if len(npmPackage.Tags) > 0 && data.DistTags[npmPackage.PackageName] == nil {
+			data.DistTags[npmPackage.PackageName] = make(map[string]string, len(npmPackage.Tags))
+		}
+		for _, tag := range npmPackage.Tags {
+			data.DistTags[npmPackage.PackageName][tag] = npmPackage.Version
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNpmPackagesDataSource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "npm"
+}
+data "artifactregistry_npm_packages" "test" {
+	package_name = "@fourthfloor/web"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.artifactregistry_npm_packages.test", "npm_packages.0.package_name", "@fourthfloor/web"),
+					resource.TestCheckResourceAttrSet("data.artifactregistry_npm_packages.test", "dist_tags.@fourthfloor/web.latest"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+const (
+	osPackageFormatApt = "APT"
+	osPackageFormatYum = "YUM"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OsPackagesDataSource{}
+
+func NewAptPackagesData() datasource.DataSource {
+	return &OsPackagesDataSource{format: osPackageFormatApt}
+}
+
+func NewYumPackagesData() datasource.DataSource {
+	return &OsPackagesDataSource{format: osPackageFormatYum}
+}
+
+// OsPackagesDataSource lists the packages of an apt or yum repository, depending on format.
+type OsPackagesDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+	format string
+}
+
+// OsPackagesDataSourceModel defines the data source model.
+type OsPackagesDataSourceModel struct {
+	ID               types.String              `tfsdk:"id"`
+	Packages         map[string]OsPackageModel `tfsdk:"packages"`
+	RepositoryConfig types.String              `tfsdk:"repository_config"`
+}
+
+// OsPackageModel describes a single package with all of its versions.
+type OsPackageModel struct {
+	Name       types.String `tfsdk:"name"`
+	Versions   []string     `tfsdk:"versions"`
+	CreateTime types.String `tfsdk:"create_time"`
+	UpdateTime types.String `tfsdk:"update_time"`
+}
+
+func (d *OsPackagesDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = fmt.Sprintf("%s_%s_packages", request.ProviderTypeName, strings.ToLower(d.format))
+}
+
+func (d *OsPackagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OsPackagesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	repositoryConfigDescription := "The line to add to /etc/apt/sources.list.d to install packages from the repository, " +
+		"which requires the apt-transport-artifact-registry package."
+	if d.format == osPackageFormatYum {
+		repositoryConfigDescription = "The content of the file to add to /etc/yum.repos.d to install packages from the repository, " +
+			"which requires the yum-plugin-artifact-registry package."
+	}
+	response.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("This data source provides the packages and versions in a %s repository, "+
+			"and the configuration to install them.", strings.ToLower(d.format)),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"packages": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "The packages in the repository, keyed by package name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The resource name of the package.",
+						},
+						"versions": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"create_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"update_time": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"repository_config": schema.StringAttribute{
+				Computed:    true,
+				Description: repositoryConfigDescription,
+			},
+		},
+	}
+}
+
+func (d *OsPackagesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := d.client
+	repository, err := client.GetRepository(ctx, client.Location, client.Repository)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to read repository", err.Error()))
+		return
+	}
+	if repository.Format != d.format {
+		response.Diagnostics.AddError("unexpected repository format",
+			fmt.Sprintf("The repository %s has the format %s, expected %s.", repository.Name, repository.Format, d.format))
+		return
+	}
+	packages, err := client.ListPackages(ctx)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list packages", err.Error()))
+		return
+	}
+
+	data := OsPackagesDataSourceModel{
+		ID:               types.StringValue(fmt.Sprintf("%s/%s/%s", client.ProjectID, client.Location, client.Repository)),
+		Packages:         make(map[string]OsPackageModel, len(packages)),
+		RepositoryConfig: types.StringValue(osRepositoryConfig(d.format, client.ProjectID, client.Location, client.Repository)),
+	}
+	for _, osPackage := range packages {
+		versions, err := client.ListVersions(ctx, osPackage.ID(), &artifactregistrydockerimagesclient.ListVersionsOptions{})
+		if err != nil {
+			response.Diagnostics.Append(diag.NewErrorDiagnostic(fmt.Sprintf("failed to list versions of %s", osPackage.ID()), err.Error()))
+			return
+		}
+		versionIDs := make([]string, 0, len(versions))
+		for _, version := range versions {
+			versionIDs = append(versionIDs, version.ID())
+		}
+		data.Packages[osPackage.ID()] = OsPackageModel{
+			Name:       types.StringValue(osPackage.Name),
+			Versions:   versionIDs,
+			CreateTime: types.StringValue(osPackage.CreateTime),
+			UpdateTime: types.StringValue(osPackage.UpdateTime),
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// osRepositoryConfig returns the apt source line or the yum repository file of a repository, as documented in
+// https://cloud.google.com/artifact-registry/docs/os-packages.
+func osRepositoryConfig(format string, project string, location string, repository string) string {
+	if format == osPackageFormatApt {
+		return fmt.Sprintf("deb ar+https://%s/projects/%s %s main",
+			artifactregistrydockerimagesclient.AptRepositoryHost(location), project, repository)
+	}
+	return fmt.Sprintf(`[%[1]s]
+name=%[1]s
+baseurl=https://%[2]s/projects/%[3]s/%[1]s
+enabled=1
+repo_gpgcheck=0
+gpgcheck=0
+`, repository, artifactregistrydockerimagesclient.YumRepositoryHost(location), project)
+}
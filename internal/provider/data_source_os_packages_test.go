@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAptPackagesDataSource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "apt"
+}
+data "artifactregistry_apt_packages" "test" {}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.artifactregistry_apt_packages.test", "repository_config",
+						"deb ar+https://europe-apt.pkg.dev/projects/devops-339608 apt main"),
+					resource.TestCheckResourceAttrSet("data.artifactregistry_apt_packages.test", "packages.%"),
+				),
+			},
+		},
+	})
+}
+
+func TestOsRepositoryConfig(t *testing.T) {
+	if config := osRepositoryConfig(osPackageFormatApt, "devops-339608", "europe-west1", "apt"); config != "deb ar+https://europe-west1-apt.pkg.dev/projects/devops-339608 apt main" {
+		t.Errorf("unexpected apt source line %q", config)
+	}
+	expected := `[yum]
+name=yum
+baseurl=https://europe-west1-yum.pkg.dev/projects/devops-339608/yum
+enabled=1
+repo_gpgcheck=0
+gpgcheck=0
+`
+	if config := osRepositoryConfig(osPackageFormatYum, "devops-339608", "europe-west1", "yum"); config != expected {
+		t.Errorf("unexpected yum repository file %q", config)
+	}
+}
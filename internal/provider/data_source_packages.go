@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PackagesDataSource{}
+
+func NewPackagesData() datasource.DataSource {
+	return &PackagesDataSource{}
+}
+
+// PackagesDataSource defines the data source implementation.
+type PackagesDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// PackagesDataSourceModel defines the data source model.
+type PackagesDataSourceModel struct {
+	ID       types.String            `tfsdk:"id"`
+	Packages map[string]PackageModel `tfsdk:"packages"`
+}
+
+// PackageModel describes a single package of the repository.
+type PackageModel struct {
+	Name        types.String      `tfsdk:"name"`
+	DisplayName types.String      `tfsdk:"display_name"`
+	CreateTime  types.String      `tfsdk:"create_time"`
+	UpdateTime  types.String      `tfsdk:"update_time"`
+	Annotations map[string]string `tfsdk:"annotations"`
+}
+
+func (d *PackagesDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_packages"
+}
+
+func (d *PackagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PackagesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the packages in a repository, keyed by package ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"packages": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "The packages in the repository, keyed by package ID (e.g. campaign-service).",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The resource name of the package.",
+						},
+						"display_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"create_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"update_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"annotations": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PackagesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := d.client
+	packages, err := client.ListPackages(ctx)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list packages", err.Error()))
+		return
+	}
+
+	data := PackagesDataSourceModel{
+		ID:       types.StringValue(fmt.Sprintf("%s/%s/%s", client.ProjectID, client.Location, client.Repository)),
+		Packages: make(map[string]PackageModel, len(packages)),
+	}
+	for _, registryPackage := range packages {
+		data.Packages[registryPackage.ID()] = PackageModel{
+			Name:        types.StringValue(registryPackage.Name),
+			DisplayName: types.StringValue(registryPackage.DisplayName),
+			CreateTime:  types.StringValue(registryPackage.CreateTime),
+			UpdateTime:  types.StringValue(registryPackage.UpdateTime),
+			Annotations: registryPackage.Annotations,
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
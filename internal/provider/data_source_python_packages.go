@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PythonPackagesDataSource{}
+
+func NewPythonPackagesData() datasource.DataSource {
+	return &PythonPackagesDataSource{}
+}
+
+// PythonPackagesDataSource defines the data source implementation.
+type PythonPackagesDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// PythonPackagesDataSourceModel defines the data source model.
+type PythonPackagesDataSourceModel struct {
+	ID                 types.String                  `tfsdk:"id"`
+	PackageName        types.String                  `tfsdk:"package_name"`
+	IncludePrereleases types.Bool                    `tfsdk:"include_prereleases"`
+	PythonPackages     []PythonPackageModel          `tfsdk:"python_packages"`
+	LatestVersions     map[string]PythonPackageModel `tfsdk:"latest_versions"`
+}
+
+// PythonPackageModel describes a single version of a python package.
+type PythonPackageModel struct {
+	Name        types.String `tfsdk:"name"`
+	PackageName types.String `tfsdk:"package_name"`
+	Version     types.String `tfsdk:"version"`
+	URI         types.String `tfsdk:"uri"`
+	CreateTime  types.String `tfsdk:"create_time"`
+	UpdateTime  types.String `tfsdk:"update_time"`
+}
+
+func (d *PythonPackagesDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_python_packages"
+}
+
+func (d *PythonPackagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PythonPackagesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	pythonPackageAttributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The resource name of the package version.",
+		},
+		"package_name": schema.StringAttribute{
+			Computed: true,
+		},
+		"version": schema.StringAttribute{
+			Computed: true,
+		},
+		"uri": schema.StringAttribute{
+			Computed:    true,
+			Description: "The URI to download the package version from.",
+		},
+		"create_time": schema.StringAttribute{
+			Computed: true,
+		},
+		"update_time": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the versions of the python packages in a python repository.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"package_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the versions of this package, e.g. campaign-client.",
+			},
+			"include_prereleases": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether pre-releases and development releases, e.g. 1.2.0rc1 or 1.2.0.dev3, can be selected as latest_versions.",
+			},
+			"python_packages": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: pythonPackageAttributes,
+				},
+			},
+			"latest_versions": schema.MapNestedAttribute{
+				Computed: true,
+				Description: "The highest version of every package according to PEP 440, keyed by package name. " +
+					"Versions that do not follow PEP 440 are never selected.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: pythonPackageAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *PythonPackagesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data PythonPackagesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.client
+	pythonPackages, err := client.ListPythonPackages(ctx)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list python packages", err.Error()))
+		return
+	}
+
+	var selected []artifactregistrydockerimagesclient.PythonPackage
+	for _, pythonPackage := range pythonPackages {
+		if data.PackageName.IsNull() || pythonPackage.PackageName == data.PackageName.ValueString() {
+			selected = append(selected, pythonPackage)
+		}
+	}
+	latestVersions := latestPythonVersions(selected, data.IncludePrereleases.ValueBool())
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", client.ProjectID, client.Location, client.Repository))
+	data.PythonPackages = make([]PythonPackageModel, 0, len(selected))
+	for _, pythonPackage := range selected {
+		data.PythonPackages = append(data.PythonPackages, pythonPackageToModel(pythonPackage))
+	}
+	data.LatestVersions = make(map[string]PythonPackageModel, len(latestVersions))
+	for packageName, pythonPackage := range latestVersions {
+		data.LatestVersions[packageName] = pythonPackageToModel(pythonPackage)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// latestPythonVersions returns the highest PEP 440 version of every package, keyed by package name. Pre-releases are
+// skipped unless includePrereleases is set, packages without a selectable version are left out.
+func latestPythonVersions(pythonPackages []artifactregistrydockerimagesclient.PythonPackage, includePrereleases bool) map[string]artifactregistrydockerimagesclient.PythonPackage {
+	latestVersions := make(map[string]artifactregistrydockerimagesclient.PythonPackage)
+	parsedVersions := make(map[string]*pep440Version)
+	for _, pythonPackage := range pythonPackages {
+		version, err := parsePep440Version(pythonPackage.Version)
+		if err != nil || (version.isPreRelease() && !includePrereleases) {
+			continue
+		}
+		if latest, ok := parsedVersions[pythonPackage.PackageName]; !ok || version.compare(latest) > 0 {
+			latestVersions[pythonPackage.PackageName] = pythonPackage
+			parsedVersions[pythonPackage.PackageName] = version
+		}
+	}
+	return latestVersions
+}
+
+func pythonPackageToModel(pythonPackage artifactregistrydockerimagesclient.PythonPackage) PythonPackageModel {
+	return PythonPackageModel{
+		Name:        types.StringValue(pythonPackage.Name),
+		PackageName: types.StringValue(pythonPackage.PackageName),
+		Version:     types.StringValue(pythonPackage.Version),
+		URI:         types.StringValue(pythonPackage.URI),
+		CreateTime:  types.StringValue(pythonPackage.CreateTime),
+		UpdateTime:  types.StringValue(pythonPackage.UpdateTime),
+	}
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"testing"
+
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPythonPackagesDataSource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "python"
+}
+data "artifactregistry_python_packages" "test" {
+	package_name = "campaign-client"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.artifactregistry_python_packages.test", "python_packages.0.package_name", "campaign-client"),
+					resource.TestCheckResourceAttrSet("data.artifactregistry_python_packages.test", "latest_versions.campaign-client.uri"),
+				),
+			},
+		},
+	})
+}
+
+func TestComparePep440Versions(t *testing.T) {
+	// Each version is lower than the next one.
+	ordered := []string{
+		"1.0.dev1",
+		"1.0a1",
+		"1.0a2.dev1",
+		"1.0a2",
+		"1.0b1",
+		"1.0rc1",
+		"1.0",
+		"1.0+local.1",
+		"1.0+local.2",
+		"1.0.post1.dev1",
+		"1.0.post1",
+		"1.1",
+		"1.10",
+		"1!0.1",
+	}
+	for i := 1; i < len(ordered); i++ {
+		lower, err := parsePep440Version(ordered[i-1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		higher, err := parsePep440Version(ordered[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lower.compare(higher) >= 0 || higher.compare(lower) <= 0 {
+			t.Errorf("expected %s to be lower than %s", ordered[i-1], ordered[i])
+		}
+	}
+
+	equal := [][2]string{{"1.0", "1.0.0"}, {"1.0-alpha1", "1.0a1"}, {"1.0-1", "1.0.post1"}, {"v2.1", "2.1"}}
+	for _, pair := range equal {
+		a, errA := parsePep440Version(pair[0])
+		b, errB := parsePep440Version(pair[1])
+		if errA != nil || errB != nil || a.compare(b) != 0 {
+			t.Errorf("expected %s to equal %s", pair[0], pair[1])
+		}
+	}
+
+	if _, err := parsePep440Version("latest"); err == nil {
+		t.Error("expected latest to be invalid")
+	}
+}
+
+func TestLatestPythonVersions(t *testing.T) {
+	pythonPackages := []artifactregistrydockerimagesclient.PythonPackage{
+		{PackageName: "campaign-client", Version: "1.9.0"},
+		{PackageName: "campaign-client", Version: "1.10.0"},
+		{PackageName: "campaign-client", Version: "2.0.0rc1"},
+		{PackageName: "campaign-client", Version: "not-a-version"},
+		{PackageName: "events", Version: "0.1.0.dev4"},
+	}
+
+	latestVersions := latestPythonVersions(pythonPackages, false)
+	if len(latestVersions) != 1 || latestVersions["campaign-client"].Version != "1.10.0" {
+		t.Errorf("expected only campaign-client 1.10.0, got %v", latestVersions)
+	}
+
+	latestVersions = latestPythonVersions(pythonPackages, true)
+	if latestVersions["campaign-client"].Version != "2.0.0rc1" || latestVersions["events"].Version != "0.1.0.dev4" {
+		t.Errorf("expected pre-releases to be selected, got %v", latestVersions)
+	}
+}
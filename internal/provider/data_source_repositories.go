@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RepositoriesDataSource{}
+
+func NewRepositoriesData() datasource.DataSource {
+	return &RepositoriesDataSource{}
+}
+
+// RepositoriesDataSource defines the data source implementation.
+type RepositoriesDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// RepositoriesDataSourceModel defines the data source model.
+type RepositoriesDataSourceModel struct {
+	ID           types.String      `tfsdk:"id"`
+	Location     types.String      `tfsdk:"location"`
+	Format       types.String      `tfsdk:"format"`
+	Labels       map[string]string `tfsdk:"labels"`
+	Repositories []RepositoryModel `tfsdk:"repositories"`
+}
+
+// RepositoryModel describes a single repository.
+type RepositoryModel struct {
+	Name         types.String      `tfsdk:"name"`
+	RepositoryID types.String      `tfsdk:"repository_id"`
+	Format       types.String      `tfsdk:"format"`
+	Mode         types.String      `tfsdk:"mode"`
+	Description  types.String      `tfsdk:"description"`
+	Labels       map[string]string `tfsdk:"labels"`
+	SizeBytes    types.Int64       `tfsdk:"size_bytes"`
+	KmsKeyName   types.String      `tfsdk:"kms_key_name"`
+	CreateTime   types.String      `tfsdk:"create_time"`
+	UpdateTime   types.String      `tfsdk:"update_time"`
+}
+
+func (d *RepositoriesDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_repositories"
+}
+
+func (d *RepositoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RepositoriesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the repositories of the project in a location.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"location": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The location to list the repositories of. Defaults to the location of the provider.",
+			},
+			"format": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list repositories of this format, e.g. DOCKER.",
+			},
+			"labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only list repositories carrying all of these labels.",
+			},
+			"repositories": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The resource name of the repository.",
+						},
+						"repository_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"format": schema.StringAttribute{
+							Computed: true,
+						},
+						"mode": schema.StringAttribute{
+							Computed:    true,
+							Description: "One of STANDARD_REPOSITORY, REMOTE_REPOSITORY or VIRTUAL_REPOSITORY.",
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"labels": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"size_bytes": schema.Int64Attribute{
+							Computed: true,
+						},
+						"kms_key_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"create_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"update_time": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RepositoriesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data RepositoriesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if data.Location.IsNull() {
+		data.Location = types.StringValue(d.client.Location)
+	}
+
+	repositories, err := d.client.ListRepositories(ctx, data.Location.ValueString())
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list repositories", err.Error()))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", d.client.ProjectID, data.Location.ValueString()))
+	data.Repositories = make([]RepositoryModel, 0, len(repositories))
+	for _, repository := range repositories {
+		if !data.Format.IsNull() && !strings.EqualFold(repository.Format, data.Format.ValueString()) {
+			continue
+		}
+		if !hasLabels(repository.Labels, data.Labels) {
+			continue
+		}
+		sizeBytes, err := parseInt64String(repository.SizeBytes)
+		if err != nil {
+			response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to parse repository size", err.Error()))
+			return
+		}
+		data.Repositories = append(data.Repositories, RepositoryModel{
+			Name:         types.StringValue(repository.Name),
+			RepositoryID: types.StringValue(repository.ID()),
+			Format:       types.StringValue(repository.Format),
+			Mode:         types.StringValue(repository.Mode),
+			Description:  types.StringValue(repository.Description),
+			Labels:       repository.Labels,
+			SizeBytes:    types.Int64Value(sizeBytes),
+			KmsKeyName:   types.StringValue(repository.KmsKeyName),
+			CreateTime:   types.StringValue(repository.CreateTime),
+			UpdateTime:   types.StringValue(repository.UpdateTime),
+		})
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// hasLabels reports whether labels contains every key/value pair of wanted.
+func hasLabels(labels map[string]string, wanted map[string]string) bool {
+	for key, value := range wanted {
+		if labelValue, ok := labels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// parseInt64String parses the JSON string encoding the API uses for int64 values, treating an omitted value as 0.
+func parseInt64String(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RepositoryIamPermissionsDataSource{}
+
+func NewRepositoryIamPermissionsData() datasource.DataSource {
+	return &RepositoryIamPermissionsDataSource{}
+}
+
+// RepositoryIamPermissionsDataSource tests which permissions the provider's credentials have on a repository.
+type RepositoryIamPermissionsDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// RepositoryIamPermissionsDataSourceModel defines the data source model.
+type RepositoryIamPermissionsDataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Repository         types.String `tfsdk:"repository"`
+	Location           types.String `tfsdk:"location"`
+	Permissions        []string     `tfsdk:"permissions"`
+	GrantedPermissions []string     `tfsdk:"granted_permissions"`
+	MissingPermissions []string     `tfsdk:"missing_permissions"`
+	AllGranted         types.Bool   `tfsdk:"all_granted"`
+}
+
+func (d *RepositoryIamPermissionsDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_repository_iam_permissions"
+}
+
+func (d *RepositoryIamPermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RepositoryIamPermissionsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source tests which of the permissions the credentials of the provider have on a repository, " +
+			"so that missing access can be caught in a precondition before deploying.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"repository": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the repository. Defaults to the repository of the provider.",
+			},
+			"location": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The location of the repository. Defaults to the location of the provider.",
+			},
+			"permissions": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The permissions to test, e.g. artifactregistry.repositories.downloadArtifacts.",
+			},
+			"granted_permissions": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The subset of permissions that is granted.",
+			},
+			"missing_permissions": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The subset of permissions that is not granted.",
+			},
+			"all_granted": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether all permissions are granted.",
+			},
+		},
+	}
+}
+
+func (d *RepositoryIamPermissionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data RepositoryIamPermissionsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	repositoryName := repositoryIamDefaults(d.client, &data.Location, &data.Repository)
+
+	granted, err := d.client.TestIamPermissions(ctx, repositoryName, data.Permissions)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to test IAM permissions", err.Error()))
+		return
+	}
+
+	data.ID = types.StringValue(repositoryName)
+	data.GrantedPermissions = make([]string, 0, len(granted))
+	data.MissingPermissions = make([]string, 0)
+	for _, permission := range data.Permissions {
+		if contains(granted, permission) {
+			data.GrantedPermissions = append(data.GrantedPermissions, permission)
+		} else {
+			data.MissingPermissions = append(data.MissingPermissions, permission)
+		}
+	}
+	data.AllGranted = types.BoolValue(len(data.MissingPermissions) == 0)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
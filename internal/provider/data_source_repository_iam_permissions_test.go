@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRepositoryIamPermissionsDataSource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+data "artifactregistry_repository_iam_permissions" "test" {
+	permissions = [
+		"artifactregistry.repositories.downloadArtifacts",
+		"artifactregistry.repositories.list",
+	]
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.artifactregistry_repository_iam_permissions.test", "id", "projects/devops-339608/locations/europe/repositories/services"),
+					resource.TestCheckResourceAttr("data.artifactregistry_repository_iam_permissions.test", "granted_permissions.0", "artifactregistry.repositories.downloadArtifacts"),
+					resource.TestCheckResourceAttr("data.artifactregistry_repository_iam_permissions.test", "all_granted", "true"),
+				),
+			},
+		},
+	})
+}
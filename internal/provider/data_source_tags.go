@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TagsDataSource{}
+
+func NewTagsData() datasource.DataSource {
+	return &TagsDataSource{}
+}
+
+// TagsDataSource defines the data source implementation.
+type TagsDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// TagsDataSourceModel defines the data source model.
+type TagsDataSourceModel struct {
+	ID          types.String      `tfsdk:"id"`
+	Package     types.String      `tfsdk:"package"`
+	NameRegex   types.String      `tfsdk:"name_regex"`
+	Tags        []TagModel        `tfsdk:"tags"`
+	TagVersions map[string]string `tfsdk:"tag_versions"`
+}
+
+// TagModel describes a single tag of a package.
+type TagModel struct {
+	Name    types.String `tfsdk:"name"`
+	Version types.String `tfsdk:"version"`
+}
+
+func (d *TagsDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_tags"
+}
+
+func (d *TagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TagsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the tags of a package and the versions they point to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"package": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the package, e.g. campaign-service.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "A regular expression the tag names must match, e.g. ^development-.",
+			},
+			"tags": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the tag, e.g. development-9681cde.",
+						},
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "The version the tag points to, which is the digest for docker images.",
+						},
+					},
+				},
+			},
+			"tag_versions": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The versions the tags point to, keyed by tag name.",
+			},
+		},
+	}
+}
+
+func (d *TagsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data TagsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			response.Diagnostics.Append(diag.NewErrorDiagnostic("invalid name_regex", err.Error()))
+			return
+		}
+	}
+
+	packageID := data.Package.ValueString()
+	tags, err := d.client.ListTags(ctx, packageID)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list tags", err.Error()))
+		return
+	}
+
+	data.ID = types.StringValue(packageID)
+	data.Tags = make([]TagModel, 0, len(tags))
+	data.TagVersions = make(map[string]string, len(tags))
+	for _, tag := range tags {
+		if nameRegex != nil && !nameRegex.MatchString(tag.ID()) {
+			continue
+		}
+		data.Tags = append(data.Tags, TagModel{
+			Name:    types.StringValue(tag.ID()),
+			Version: types.StringValue(tag.VersionID()),
+		})
+		data.TagVersions[tag.ID()] = tag.VersionID()
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
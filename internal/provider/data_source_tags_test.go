@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagsDataSource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+data "artifactregistry_tags" "test" {
+	package = "campaign-service"
+	name_regex = "^development-"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.artifactregistry_tags.test", "tags.0.name", regexp.MustCompile("^development-")),
+					resource.TestMatchResourceAttr("data.artifactregistry_tags.test", "tag_versions.development-9681cde", regexp.MustCompile("^sha256:")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VersionsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VersionsDataSource{}
+
+func NewVersionsData() datasource.DataSource {
+	return &VersionsDataSource{}
+}
+
+// VersionsDataSource defines the data source implementation.
+type VersionsDataSource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// VersionsDataSourceModel defines the data source model.
+type VersionsDataSourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Package  types.String   `tfsdk:"package"`
+	OrderBy  types.String   `tfsdk:"order_by"`
+	Limit    types.Int64    `tfsdk:"limit"`
+	Versions []VersionModel `tfsdk:"versions"`
+}
+
+// VersionModel describes a single version of a package.
+type VersionModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	CreateTime  types.String `tfsdk:"create_time"`
+	UpdateTime  types.String `tfsdk:"update_time"`
+	RelatedTags []string     `tfsdk:"related_tags"`
+	Metadata    types.String `tfsdk:"metadata"`
+}
+
+func (d *VersionsDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_versions"
+}
+
+func (d *VersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VersionsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the versions of a package with their related tags and metadata.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"package": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the package, e.g. campaign-service.",
+			},
+			"order_by": schema.StringAttribute{
+				Optional:    true,
+				Description: "The field to order the versions by, e.g. \"create_time desc\" for the most recent versions first.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of versions to return, at least 1.",
+			},
+			"versions": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the version, which is the digest for docker images.",
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"create_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"update_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"related_tags": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The names of the tags pointing to the version.",
+						},
+						"metadata": schema.StringAttribute{
+							Computed:    true,
+							Description: "The format specific metadata of the version, JSON encoded.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VersionsDataSource) ValidateConfig(ctx context.Context, request datasource.ValidateConfigRequest, response *datasource.ValidateConfigResponse) {
+	var limit types.Int64
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("limit"), &limit)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(validateVersionsLimit(path.Root("limit"), limit)...)
+}
+
+func (d *VersionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data VersionsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The limit may only be known now, when it comes from another resource.
+	response.Diagnostics.Append(validateVersionsLimit(path.Root("limit"), data.Limit)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	packageID := data.Package.ValueString()
+	versions, err := d.client.ListVersions(ctx, packageID, &artifactregistrydockerimagesclient.ListVersionsOptions{
+		OrderBy: data.OrderBy.ValueString(),
+		Limit:   int(data.Limit.ValueInt64()),
+	})
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list versions", err.Error()))
+		return
+	}
+
+	data.ID = types.StringValue(packageID)
+	data.Versions = make([]VersionModel, 0, len(versions))
+	for _, version := range versions {
+		relatedTags := make([]string, 0, len(version.RelatedTags))
+		for _, tag := range version.RelatedTags {
+			relatedTags = append(relatedTags, tag.ID())
+		}
+		metadata := types.StringNull()
+		if len(version.Metadata) > 0 {
+			metadata = types.StringValue(string(version.Metadata))
+		}
+		data.Versions = append(data.Versions, VersionModel{
+			Name:        types.StringValue(version.ID()),
+			Description: types.StringValue(version.Description),
+			CreateTime:  types.StringValue(version.CreateTime),
+			UpdateTime:  types.StringValue(version.UpdateTime),
+			RelatedTags: relatedTags,
+			Metadata:    metadata,
+		})
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// validateVersionsLimit rejects a limit below 1, which listAll would treat as no limit. Null and unknown limits are
+// skipped.
+func validateVersionsLimit(attributePath path.Path, limit types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !limit.IsNull() && !limit.IsUnknown() && limit.ValueInt64() < 1 {
+		diags.AddAttributeError(attributePath, "invalid limit", fmt.Sprintf("The limit must be at least 1, got %d.", limit.ValueInt64()))
+	}
+	return diags
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVersionsDataSource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+data "artifactregistry_versions" "test" {
+	package = "campaign-service"
+	order_by = "create_time desc"
+	limit = 3
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.artifactregistry_versions.test", "versions.#", "3"),
+					resource.TestMatchResourceAttr("data.artifactregistry_versions.test", "versions.0.name", regexp.MustCompile("^sha256:")),
+					resource.TestCheckResourceAttrSet("data.artifactregistry_versions.test", "versions.0.metadata"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateVersionsLimit(t *testing.T) {
+	testCases := map[string]struct {
+		limit types.Int64
+		valid bool
+	}{
+		"null":     {limit: types.Int64Null(), valid: true},
+		"unknown":  {limit: types.Int64Unknown(), valid: true},
+		"one":      {limit: types.Int64Value(1), valid: true},
+		"zero":     {limit: types.Int64Value(0)},
+		"negative": {limit: types.Int64Value(-5)},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := validateVersionsLimit(path.Root("limit"), testCase.limit)
+			if diags.HasError() == testCase.valid {
+				t.Errorf("expected valid to be %t, got %v", testCase.valid, diags)
+			}
+		})
+	}
+}
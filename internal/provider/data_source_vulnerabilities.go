@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"sort"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VulnerabilitiesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VulnerabilitiesDataSource{}
+
+// imageDigestRegex matches the digest of an image manifest. The data source refuses anything else, as an image that
+// is not found simply has no vulnerabilities.
+var imageDigestRegex = regexp.MustCompile(`^sha256:[0-9a-f]{64}$`)
+
+// vulnerabilitySeverities are the severities of Container Analysis, from the least to the most severe.
+var vulnerabilitySeverities = []string{"SEVERITY_UNSPECIFIED", "MINIMAL", "LOW", "MEDIUM", "HIGH", "CRITICAL"}
+
+func NewVulnerabilitiesData() datasource.DataSource {
+	return &VulnerabilitiesDataSource{}
+}
+
+// vulnerabilityOccurrenceLister is the part of the client the data source needs, so the tests can replace it with a fake.
+type vulnerabilityOccurrenceLister interface {
+	ImageResourceURL(image string, digest string) string
+	ListVulnerabilityOccurrences(ctx context.Context, resourceURL string) ([]artifactregistrydockerimagesclient.Occurrence, error)
+}
+
+// VulnerabilitiesDataSource defines the data source implementation.
+type VulnerabilitiesDataSource struct {
+	client vulnerabilityOccurrenceLister
+}
+
+// VulnerabilitiesDataSourceModel defines the data source model.
+type VulnerabilitiesDataSourceModel struct {
+	ID             types.String                `tfsdk:"id"`
+	Image          types.String                `tfsdk:"image"`
+	Digest         types.String                `tfsdk:"digest"`
+	ResourceURL    types.String                `tfsdk:"resource_url"`
+	Findings       []VulnerabilityFindingModel `tfsdk:"findings"`
+	SeverityCounts map[string]int64            `tfsdk:"severity_counts"`
+}
+
+// VulnerabilityFindingModel describes a vulnerability of a single package of the image.
+type VulnerabilityFindingModel struct {
+	CVE              types.String  `tfsdk:"cve"`
+	Severity         types.String  `tfsdk:"severity"`
+	CvssScore        types.Float64 `tfsdk:"cvss_score"`
+	FixAvailable     types.Bool    `tfsdk:"fix_available"`
+	Package          types.String  `tfsdk:"package"`
+	PackageType      types.String  `tfsdk:"package_type"`
+	InstalledVersion types.String  `tfsdk:"installed_version"`
+	FixedVersion     types.String  `tfsdk:"fixed_version"`
+	Description      types.String  `tfsdk:"description"`
+}
+
+func (d *VulnerabilitiesDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_vulnerabilities"
+}
+
+func (d *VulnerabilitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VulnerabilitiesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the vulnerabilities Container Analysis found in a version of a docker image, " +
+			"e.g. to block deployments with a precondition on `severity_counts[\"CRITICAL\"] == 0`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"image": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the image in the repository, e.g. campaign-service.",
+			},
+			"digest": schema.StringAttribute{
+				Required:    true,
+				Description: "The digest of the image, e.g. sha256:... Tags are not accepted, as the scan results belong to a digest.",
+			},
+			"resource_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL Container Analysis knows the image by.",
+			},
+			"findings": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The vulnerabilities of the image, one per affected package, the most severe first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cve": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the vulnerability, e.g. CVE-2023-4911.",
+						},
+						"severity": schema.StringAttribute{
+							Computed: true,
+							Description: "The severity as assessed by the distribution of the package, or by the vulnerability " +
+								"database when the distribution did not assess it. One of MINIMAL, LOW, MEDIUM, HIGH or CRITICAL.",
+						},
+						"cvss_score": schema.Float64Attribute{
+							Computed: true,
+						},
+						"fix_available": schema.BoolAttribute{
+							Computed: true,
+						},
+						"package": schema.StringAttribute{
+							Computed: true,
+						},
+						"package_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the package, e.g. OS or GO.",
+						},
+						"installed_version": schema.StringAttribute{
+							Computed: true,
+						},
+						"fixed_version": schema.StringAttribute{
+							Computed:    true,
+							Description: "The first version of the package without the vulnerability. Only set when a fix is available.",
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"severity_counts": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "The number of distinct vulnerabilities (CVEs) per severity, a vulnerability affecting several " +
+					"packages is counted once with its highest severity. Every severity is present, with a count of 0 when it was not found.",
+			},
+		},
+	}
+}
+
+func (d *VulnerabilitiesDataSource) ValidateConfig(ctx context.Context, request datasource.ValidateConfigRequest, response *datasource.ValidateConfigResponse) {
+	var digest types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("digest"), &digest)...)
+	if response.Diagnostics.HasError() || digest.IsUnknown() || digest.IsNull() {
+		return
+	}
+	if err := validateImageDigest(digest.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("digest"), "invalid digest", err.Error())
+	}
+}
+
+func (d *VulnerabilitiesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data VulnerabilitiesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The digest is usually unknown during validation, as it comes from another data source, so it is checked again.
+	if err := validateImageDigest(data.Digest.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("digest"), "invalid digest", err.Error())
+		return
+	}
+	if err := readVulnerabilities(ctx, d.client, &data); err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to list vulnerabilities", err.Error()))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// validateImageDigest rejects anything but the digest of an image manifest.
+func validateImageDigest(digest string) error {
+	if !imageDigestRegex.MatchString(digest) {
+		return fmt.Errorf("the digest must look like sha256:<64 hex characters>, got %q", digest)
+	}
+	return nil
+}
+
+// readVulnerabilities fills the computed attributes of data with the vulnerabilities of the image it references.
+func readVulnerabilities(ctx context.Context, client vulnerabilityOccurrenceLister, data *VulnerabilitiesDataSourceModel) error {
+	resourceURL := client.ImageResourceURL(data.Image.ValueString(), data.Digest.ValueString())
+	occurrences, err := client.ListVulnerabilityOccurrences(ctx, resourceURL)
+	if err != nil {
+		return err
+	}
+
+	data.ID = types.StringValue(resourceURL)
+	data.ResourceURL = types.StringValue(resourceURL)
+	data.Findings = vulnerabilityFindings(occurrences)
+	data.SeverityCounts = make(map[string]int64, len(vulnerabilitySeverities))
+	for _, severity := range vulnerabilitySeverities {
+		data.SeverityCounts[severity] = 0
+	}
+	// A vulnerability affecting several packages is counted once, with the highest severity of its findings.
+	cveSeverities := make(map[string]string)
+	for _, finding := range data.Findings {
+		severity, ok := cveSeverities[finding.CVE.ValueString()]
+		if !ok || vulnerabilitySeverityRank(finding.Severity.ValueString()) > vulnerabilitySeverityRank(severity) {
+			cveSeverities[finding.CVE.ValueString()] = finding.Severity.ValueString()
+		}
+	}
+	for _, severity := range cveSeverities {
+		data.SeverityCounts[severity]++
+	}
+	return nil
+}
+
+// vulnerabilityFindings flattens the occurrences into one finding per affected package, sorted by descending severity
+// and CVSS score, then by CVE and package.
+func vulnerabilityFindings(occurrences []artifactregistrydockerimagesclient.Occurrence) []VulnerabilityFindingModel {
+	findings := make([]VulnerabilityFindingModel, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		vulnerability := occurrence.Vulnerability
+		if vulnerability == nil {
+			continue
+		}
+		severity := vulnerability.EffectiveSeverity
+		if severity == "" {
+			severity = vulnerability.Severity
+		}
+		if severity == "" {
+			severity = vulnerabilitySeverities[0]
+		}
+		finding := VulnerabilityFindingModel{
+			CVE:              types.StringValue(occurrence.NoteID()),
+			Severity:         types.StringValue(severity),
+			CvssScore:        types.Float64Value(vulnerability.CvssScore),
+			FixAvailable:     types.BoolValue(vulnerability.FixAvailable),
+			Package:          types.StringNull(),
+			PackageType:      types.StringNull(),
+			InstalledVersion: types.StringNull(),
+			FixedVersion:     types.StringNull(),
+			Description:      stringValueOrNull(vulnerability.ShortDescription),
+		}
+		if len(vulnerability.PackageIssue) == 0 {
+			findings = append(findings, finding)
+			continue
+		}
+		for _, issue := range vulnerability.PackageIssue {
+			packageFinding := finding
+			packageFinding.FixAvailable = types.BoolValue(issue.FixAvailable)
+			packageFinding.Package = stringValueOrNull(issue.AffectedPackage)
+			packageFinding.PackageType = stringValueOrNull(issue.PackageType)
+			packageFinding.InstalledVersion = stringValueOrNull(issue.AffectedVersion.FullName)
+			if issue.FixAvailable {
+				packageFinding.FixedVersion = stringValueOrNull(issue.FixedVersion.FullName)
+			}
+			findings = append(findings, packageFinding)
+		}
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if rankA, rankB := vulnerabilitySeverityRank(a.Severity.ValueString()), vulnerabilitySeverityRank(b.Severity.ValueString()); rankA != rankB {
+			return rankA > rankB
+		}
+		if a.CvssScore.ValueFloat64() != b.CvssScore.ValueFloat64() {
+			return a.CvssScore.ValueFloat64() > b.CvssScore.ValueFloat64()
+		}
+		if a.CVE.ValueString() != b.CVE.ValueString() {
+			return a.CVE.ValueString() < b.CVE.ValueString()
+		}
+		return a.Package.ValueString() < b.Package.ValueString()
+	})
+	return findings
+}
+
+// vulnerabilitySeverityRank orders the severities, unknown severities rank like SEVERITY_UNSPECIFIED.
+func vulnerabilitySeverityRank(severity string) int {
+	for rank, s := range vulnerabilitySeverities {
+		if s == severity {
+			return rank
+		}
+	}
+	return 0
+}
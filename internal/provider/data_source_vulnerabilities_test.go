@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// fakeVulnerabilityOccurrenceLister serves canned occurrences per resource URL instead of calling Container Analysis.
+type fakeVulnerabilityOccurrenceLister struct {
+	occurrences map[string][]artifactregistrydockerimagesclient.Occurrence
+}
+
+func (f *fakeVulnerabilityOccurrenceLister) ImageResourceURL(image string, digest string) string {
+	return fmt.Sprintf("https://europe-docker.pkg.dev/devops-339608/services/%s@%s", image, digest)
+}
+
+func (f *fakeVulnerabilityOccurrenceLister) ListVulnerabilityOccurrences(ctx context.Context, resourceURL string) ([]artifactregistrydockerimagesclient.Occurrence, error) {
+	return f.occurrences[resourceURL], nil
+}
+
+func TestAccVulnerabilitiesDataSource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+data "artifactregistry_versions" "campaign_service" {
+	package = "campaign-service"
+}
+data "artifactregistry_vulnerabilities" "test" {
+	image  = "campaign-service"
+	digest = data.artifactregistry_versions.campaign_service.versions[0].name
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.artifactregistry_vulnerabilities.test", "resource_url"),
+					resource.TestCheckResourceAttrSet("data.artifactregistry_vulnerabilities.test", "severity_counts.CRITICAL"),
+				),
+			},
+		},
+	})
+}
+
+func TestReadVulnerabilities(t *testing.T) {
+	digest := "sha256:5f70bf18a086007016e948b04aed3b82103a36bea41755b6cddfaf10ace3c6ef"
+	fake := &fakeVulnerabilityOccurrenceLister{occurrences: map[string][]artifactregistrydockerimagesclient.Occurrence{
+		"https://europe-docker.pkg.dev/devops-339608/services/campaign-service@" + digest: {
+			{
+				NoteName: "projects/goog-vulnz/notes/CVE-2023-0464",
+				Kind:     "VULNERABILITY",
+				Vulnerability: &artifactregistrydockerimagesclient.VulnerabilityOccurrence{
+					Severity:          "HIGH",
+					EffectiveSeverity: "MEDIUM",
+					CvssScore:         7.5,
+					PackageIssue: []artifactregistrydockerimagesclient.PackageIssue{{
+						AffectedPackage: "openssl",
+						AffectedVersion: artifactregistrydockerimagesclient.PackageVersion{FullName: "3.0.8-1"},
+						FixedVersion:    artifactregistrydockerimagesclient.PackageVersion{Kind: "MAXIMUM"},
+						PackageType:     "OS",
+					}},
+				},
+			},
+			{
+				NoteName: "projects/goog-vulnz/notes/CVE-2023-4911",
+				Kind:     "VULNERABILITY",
+				Vulnerability: &artifactregistrydockerimagesclient.VulnerabilityOccurrence{
+					Severity:     "CRITICAL",
+					CvssScore:    7.8,
+					FixAvailable: true,
+					PackageIssue: []artifactregistrydockerimagesclient.PackageIssue{
+						{
+							AffectedPackage: "glibc",
+							AffectedVersion: artifactregistrydockerimagesclient.PackageVersion{FullName: "2.36-9"},
+							FixedVersion:    artifactregistrydockerimagesclient.PackageVersion{FullName: "2.36-9+deb12u3"},
+							FixAvailable:    true,
+							PackageType:     "OS",
+						},
+						{
+							AffectedPackage: "libc-bin",
+							AffectedVersion: artifactregistrydockerimagesclient.PackageVersion{FullName: "2.36-9"},
+							FixedVersion:    artifactregistrydockerimagesclient.PackageVersion{FullName: "2.36-9+deb12u3"},
+							FixAvailable:    true,
+							PackageType:     "OS",
+						},
+					},
+				},
+			},
+		},
+	}}
+
+	data := VulnerabilitiesDataSourceModel{
+		Image:  types.StringValue("campaign-service"),
+		Digest: types.StringValue(digest),
+	}
+	if err := readVulnerabilities(context.Background(), fake, &data); err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Findings) != 3 {
+		t.Fatalf("expected 3 findings, got %d", len(data.Findings))
+	}
+	first, last := data.Findings[0], data.Findings[2]
+	if first.CVE.ValueString() != "CVE-2023-4911" || first.Package.ValueString() != "glibc" || first.FixedVersion.ValueString() != "2.36-9+deb12u3" {
+		t.Errorf("expected the critical glibc finding first, got %s in %s", first.CVE.ValueString(), first.Package.ValueString())
+	}
+	if last.Severity.ValueString() != "MEDIUM" || last.FixAvailable.ValueBool() || !last.FixedVersion.IsNull() {
+		t.Errorf("expected the effective severity and no fix for openssl, got %s", last.Severity.ValueString())
+	}
+	// CVE-2023-4911 affects glibc and libc-bin, but is a single critical vulnerability.
+	expectedCounts := map[string]int64{"SEVERITY_UNSPECIFIED": 0, "MINIMAL": 0, "LOW": 0, "MEDIUM": 1, "HIGH": 0, "CRITICAL": 1}
+	for severity, count := range expectedCounts {
+		if data.SeverityCounts[severity] != count {
+			t.Errorf("expected %d %s findings, got %d", count, severity, data.SeverityCounts[severity])
+		}
+	}
+
+	// An image without findings still reports every severity, so preconditions can index severity_counts.
+	data.Digest = types.StringValue("sha256:0000000000000000000000000000000000000000000000000000000000000000")
+	if err := readVulnerabilities(context.Background(), fake, &data); err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Findings) != 0 || len(data.SeverityCounts) != len(vulnerabilitySeverities) || data.SeverityCounts["CRITICAL"] != 0 {
+		t.Errorf("expected no findings, got %d", len(data.Findings))
+	}
+}
+
+func TestValidateImageDigest(t *testing.T) {
+	if err := validateImageDigest("sha256:5f70bf18a086007016e948b04aed3b82103a36bea41755b6cddfaf10ace3c6ef"); err != nil {
+		t.Errorf("expected a valid digest, got %v", err)
+	}
+	for _, digest := range []string{"", "latest", "sha256:5f70bf18", "sha256:5F70BF18A086007016E948B04AED3B82103A36BEA41755B6CDDFAF10ACE3C6EF"} {
+		if validateImageDigest(digest) == nil {
+			t.Errorf("expected %q to be invalid", digest)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// mavenQualifiers are the well-known qualifiers in their order, the empty qualifier is a release. Unknown qualifiers
+// sort after all of them, alphabetically.
+var mavenQualifiers = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
+
+// mavenQualifierAliases are the qualifiers that are spelled differently but mean the same.
+var mavenQualifierAliases = map[string]string{"ga": "", "final": "", "release": "", "cr": "rc"}
+
+// mavenReleaseQualifier is the comparable form of the empty qualifier.
+var mavenReleaseQualifier = strconv.Itoa(len(mavenQualifiers) - 2)
+
+// mavenVersionItem is an item of a version as parsed by Maven's ComparableVersion, see
+// https://maven.apache.org/pom.html#version-order-specification. compare accepts a nil other, which stands for the
+// padding of the shorter version, e.g. 0 for numbers and the release qualifier for strings.
+type mavenVersionItem interface {
+	compare(other mavenVersionItem) int
+	isNull() bool
+}
+
+// mavenIntItem is a number, kept as its decimal digits without leading zeros so that it cannot overflow.
+type mavenIntItem string
+
+// mavenStringItem is a qualifier in its comparable form, e.g. 3 for rc or 7-abc for the unknown qualifier abc.
+type mavenStringItem string
+
+// mavenListItem is the sub-list started by a hyphen or by a transition between digits and letters.
+type mavenListItem []mavenVersionItem
+
+// parseMavenVersion parses a version the way Maven orders it. Every string is a valid Maven version.
+func parseMavenVersion(version string) mavenListItem {
+	version = strings.ToLower(version)
+	root := &mavenListItem{}
+	list := root
+	var stack []*mavenListItem
+	// startList adds a new sub-list to the current list and continues in it.
+	startList := func() {
+		sublist := &mavenListItem{}
+		stack = append(stack, list)
+		*list = append(*list, sublist)
+		list = sublist
+	}
+
+	isDigit, start := false, 0
+	runes := []rune(version)
+	for i, r := range runes {
+		switch {
+		case r == '.' || r == '-':
+			if i == start {
+				*list = append(*list, mavenIntItem(""))
+			} else {
+				*list = append(*list, parseMavenVersionItem(isDigit, string(runes[start:i]), false))
+			}
+			start = i + 1
+			if r == '-' {
+				startList()
+			}
+		case unicode.IsDigit(r):
+			if !isDigit && i > start {
+				*list = append(*list, parseMavenVersionItem(false, string(runes[start:i]), true))
+				start = i
+				startList()
+			}
+			isDigit = true
+		default:
+			if isDigit && i > start {
+				*list = append(*list, parseMavenVersionItem(true, string(runes[start:i]), false))
+				start = i
+				startList()
+			}
+			isDigit = false
+		}
+	}
+	if len(runes) > start {
+		*list = append(*list, parseMavenVersionItem(isDigit, string(runes[start:]), false))
+	}
+	list.normalize()
+	for i := len(stack) - 1; i >= 0; i-- {
+		stack[i].normalize()
+	}
+	return *root
+}
+
+// parseMavenVersionItem turns a run of digits or letters into an item. A single letter followed by a digit is the
+// short form of a qualifier, e.g. 1.0a1 is 1.0-alpha-1.
+func parseMavenVersionItem(isDigit bool, value string, followedByDigit bool) mavenVersionItem {
+	if isDigit {
+		return mavenIntItem(strings.TrimLeft(value, "0"))
+	}
+	if followedByDigit && len(value) == 1 {
+		switch value {
+		case "a":
+			value = "alpha"
+		case "b":
+			value = "beta"
+		case "m":
+			value = "milestone"
+		}
+	}
+	if alias, ok := mavenQualifierAliases[value]; ok {
+		value = alias
+	}
+	for i, qualifier := range mavenQualifiers {
+		if qualifier == value {
+			return mavenStringItem(strconv.Itoa(i))
+		}
+	}
+	return mavenStringItem(strconv.Itoa(len(mavenQualifiers)) + "-" + value)
+}
+
+// normalize removes the trailing null items of the list, e.g. 1.0.0 becomes 1, up to the last item that is neither
+// null nor a sub-list.
+func (l *mavenListItem) normalize() {
+	for i := len(*l) - 1; i >= 0; i-- {
+		item := (*l)[i]
+		if item.isNull() {
+			*l = append((*l)[:i], (*l)[i+1:]...)
+		} else if _, ok := item.(*mavenListItem); !ok {
+			break
+		}
+	}
+}
+
+func (i mavenIntItem) isNull() bool {
+	return i == ""
+}
+
+func (i mavenIntItem) compare(other mavenVersionItem) int {
+	switch other := other.(type) {
+	case nil:
+		if i.isNull() {
+			return 0
+		}
+		return 1
+	case mavenIntItem:
+		if c := compareInts(len(i), len(other)); c != 0 {
+			return c
+		}
+		return strings.Compare(string(i), string(other))
+	}
+	// Numbers sort after qualifiers and sub-lists, e.g. 1.1 is greater than 1-1 and 1.sp.
+	return 1
+}
+
+func (s mavenStringItem) isNull() bool {
+	return string(s) == mavenReleaseQualifier
+}
+
+func (s mavenStringItem) compare(other mavenVersionItem) int {
+	switch other := other.(type) {
+	case nil:
+		return strings.Compare(string(s), mavenReleaseQualifier)
+	case mavenStringItem:
+		return strings.Compare(string(s), string(other))
+	}
+	// Qualifiers sort before numbers and sub-lists, e.g. 1-sp is lower than 1-1.
+	return -1
+}
+
+func (l *mavenListItem) isNull() bool {
+	return len(*l) == 0
+}
+
+func (l *mavenListItem) compare(other mavenVersionItem) int {
+	switch other := other.(type) {
+	case nil:
+		if len(*l) == 0 {
+			return 0
+		}
+		return (*l)[0].compare(nil)
+	case mavenIntItem:
+		return -1
+	case mavenStringItem:
+		return 1
+	case *mavenListItem:
+		return l.compareList(*other)
+	}
+	return 0
+}
+
+// compareList compares the items pairwise, padding the shorter list with nil.
+func (l mavenListItem) compareList(other mavenListItem) int {
+	for i := 0; i < len(l) || i < len(other); i++ {
+		var item, otherItem mavenVersionItem
+		if i < len(l) {
+			item = l[i]
+		}
+		if i < len(other) {
+			otherItem = other[i]
+		}
+		var c int
+		if item == nil {
+			if otherItem != nil {
+				c = -otherItem.compare(nil)
+			}
+		} else {
+			c = item.compare(otherItem)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareMavenVersions returns -1, 0 or 1 when version is lower than, equal to or greater than other in Maven's
+// version order.
+func compareMavenVersions(version string, other string) int {
+	return parseMavenVersion(version).compareList(parseMavenVersion(other))
+}
@@ -0,0 +1,31 @@
+package provider
+
+import "testing"
+
+func TestCompareMavenVersions(t *testing.T) {
+	// Each list is in ascending order, as in the tests of Maven's ComparableVersion.
+	orderedLists := [][]string{
+		{"1-alpha2snapshot", "1-alpha2", "1-alpha-123", "1-beta-2", "1-beta123", "1-m2", "1-m11", "1-rc", "1-cr2",
+			"1-rc123", "1-SNAPSHOT", "1", "1-sp", "1-sp2", "1-sp123", "1-abc", "1-def", "1-pom-1", "1-1-snapshot",
+			"1-1", "1-2", "1-123"},
+		{"2.0", "2-1", "2.0.a", "2.0.0.a", "2.0.2", "2.0.123", "2.1.0", "2.1-a", "2.1b", "2.1-c", "2.1-1", "2.1.0.1",
+			"2.2", "2.123", "11.a2", "11.a11", "11.b2", "11.b11", "11.m2", "11.m11", "11", "11.a", "11b", "11c", "11m"},
+		{"1.9.0", "1.10.0", "2.0.0-RC1", "2.0.0", "10.0.0", "123456789012345678901234567890"},
+	}
+	for _, ordered := range orderedLists {
+		for i := 1; i < len(ordered); i++ {
+			for j := 0; j < i; j++ {
+				if compareMavenVersions(ordered[j], ordered[i]) >= 0 || compareMavenVersions(ordered[i], ordered[j]) <= 0 {
+					t.Errorf("expected %s to be lower than %s", ordered[j], ordered[i])
+				}
+			}
+		}
+	}
+
+	equal := [][2]string{{"1", "1.0.0"}, {"1", "1-ga"}, {"1", "1.final"}, {"1a1", "1-alpha-1"}, {"1-rc1", "1-cr1"}, {"1.0.0-SNAPSHOT", "1-snapshot"}}
+	for _, pair := range equal {
+		if compareMavenVersions(pair[0], pair[1]) != 0 {
+			t.Errorf("expected %s to equal %s", pair[0], pair[1])
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// pep440Regex matches the version scheme of https://peps.python.org/pep-0440/, including the alternative spellings
+// pip normalizes, e.g. 1.0-alpha1 for 1.0a1.
+var pep440Regex = regexp.MustCompile(`(?i)^\s*v?` +
+	`(?:(?P<epoch>[0-9]+)!)?` +
+	`(?P<release>[0-9]+(?:\.[0-9]+)*)` +
+	`(?:[-_.]?(?P<pre_l>alpha|a|beta|b|preview|pre|c|rc)[-_.]?(?P<pre_n>[0-9]+)?)?` +
+	`(?:-(?P<post_n1>[0-9]+)|[-_.]?(?P<post_l>post|rev|r)[-_.]?(?P<post_n2>[0-9]+)?)?` +
+	`(?:[-_.]?(?P<dev_l>dev)[-_.]?(?P<dev_n>[0-9]+)?)?` +
+	`(?:\+(?P<local>[a-z0-9]+(?:[-_.][a-z0-9]+)*))?\s*$`)
+
+// pep440PreReleaseRanks orders the pre-release labels, with their alternative spellings.
+var pep440PreReleaseRanks = map[string]int{
+	"a": 0, "alpha": 0,
+	"b": 1, "beta": 1,
+	"rc": 2, "c": 2, "pre": 2, "preview": 2,
+}
+
+// pep440Version is a parsed version. Missing segments hold the sentinels that give them their PEP 440 ordering, e.g.
+// a final release sorts after all of its pre-releases.
+type pep440Version struct {
+	epoch   int
+	release []int
+	// preRank and pre order pre-releases, preRank is math.MaxInt for releases without a pre-release segment and
+	// math.MinInt for development releases of a final release, e.g. 1.0.dev1.
+	preRank int
+	pre     int
+	// post is -1 without a post-release segment.
+	post int
+	// dev is math.MaxInt without a development release segment.
+	dev   int
+	local []string
+}
+
+func parsePep440Version(version string) (*pep440Version, error) {
+	matches := pep440Regex.FindStringSubmatch(version)
+	if matches == nil {
+		return nil, fmt.Errorf("%q is not a PEP 440 version", version)
+	}
+	group := func(name string) string {
+		return matches[pep440Regex.SubexpIndex(name)]
+	}
+	number := func(value string) (int, error) {
+		if value == "" {
+			return 0, nil
+		}
+		return strconv.Atoi(value)
+	}
+
+	var err error
+	parsed := &pep440Version{preRank: math.MaxInt, post: -1, dev: math.MaxInt}
+	if parsed.epoch, err = number(group("epoch")); err != nil {
+		return nil, err
+	}
+	for _, segment := range strings.Split(group("release"), ".") {
+		value, err := number(segment)
+		if err != nil {
+			return nil, err
+		}
+		parsed.release = append(parsed.release, value)
+	}
+	// Trailing zeros do not change the release, 1.0 and 1.0.0 are the same version.
+	for len(parsed.release) > 1 && parsed.release[len(parsed.release)-1] == 0 {
+		parsed.release = parsed.release[:len(parsed.release)-1]
+	}
+	if label := group("pre_l"); label != "" {
+		parsed.preRank = pep440PreReleaseRanks[strings.ToLower(label)]
+		if parsed.pre, err = number(group("pre_n")); err != nil {
+			return nil, err
+		}
+	}
+	if group("post_n1") != "" || group("post_l") != "" {
+		if parsed.post, err = number(group("post_n1") + group("post_n2")); err != nil {
+			return nil, err
+		}
+	}
+	if group("dev_l") != "" {
+		if parsed.dev, err = number(group("dev_n")); err != nil {
+			return nil, err
+		}
+		if group("pre_l") == "" && parsed.post < 0 {
+			parsed.preRank = math.MinInt
+		}
+	}
+	if local := group("local"); local != "" {
+		parsed.local = strings.FieldsFunc(strings.ToLower(local), func(r rune) bool {
+			return r == '-' || r == '_' || r == '.'
+		})
+	}
+	return parsed, nil
+}
+
+// isPreRelease reports whether the version is a pre-release or a development release, which pip ignores unless asked.
+func (v *pep440Version) isPreRelease() bool {
+	return v.preRank != math.MaxInt || v.dev != math.MaxInt
+}
+
+// compare returns -1, 0 or 1 when v is lower than, equal to or greater than other.
+func (v *pep440Version) compare(other *pep440Version) int {
+	if c := compareInts(v.epoch, other.epoch); c != 0 {
+		return c
+	}
+	for i := 0; i < len(v.release) || i < len(other.release); i++ {
+		var segment, otherSegment int
+		if i < len(v.release) {
+			segment = v.release[i]
+		}
+		if i < len(other.release) {
+			otherSegment = other.release[i]
+		}
+		if c := compareInts(segment, otherSegment); c != 0 {
+			return c
+		}
+	}
+	for _, pair := range [][2]int{{v.preRank, other.preRank}, {v.pre, other.pre}, {v.post, other.post}, {v.dev, other.dev}} {
+		if c := compareInts(pair[0], pair[1]); c != 0 {
+			return c
+		}
+	}
+	return compareLocalVersions(v.local, other.local)
+}
+
+// compareLocalVersions orders local version labels segment by segment, numeric segments sort after alphanumeric ones
+// and a version without a local label sorts first.
+func compareLocalVersions(local []string, other []string) int {
+	for i := 0; i < len(local) && i < len(other); i++ {
+		number, numberErr := strconv.Atoi(local[i])
+		otherNumber, otherNumberErr := strconv.Atoi(other[i])
+		switch {
+		case numberErr == nil && otherNumberErr == nil:
+			if c := compareInts(number, otherNumber); c != 0 {
+				return c
+			}
+		case numberErr == nil:
+			return 1
+		case otherNumberErr == nil:
+			return -1
+		default:
+			if c := strings.Compare(local[i], other[i]); c != 0 {
+				return c
+			}
+		}
+	}
+	return compareInts(len(local), len(other))
+}
+
+func compareInts(a int, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
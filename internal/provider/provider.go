@@ -26,11 +26,36 @@ type ArtifactRegistryProvider struct {
 func (p *ArtifactRegistryProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewArtifactRegistryImagesData,
+		NewDockerImagePlatformsData,
+		NewDockerImageConfigData,
+		NewPackagesData,
+		NewTagsData,
+		NewVersionsData,
+		NewMavenArtifactsData,
+		NewNpmPackagesData,
+		NewPythonPackagesData,
+		NewFilesData,
+		NewGoModulesData,
+		NewAptPackagesData,
+		NewYumPackagesData,
+		NewHelmChartsData,
+		NewVulnerabilitiesData,
+		NewRepositoriesData,
+		NewLocationsData,
+		NewCleanupPolicySimulationData,
+		NewRepositoryIamPermissionsData,
 	}
 }
 
 func (p *ArtifactRegistryProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		NewDockerImageDeletionResource,
+		NewRepositoryResource,
+		NewRepositoryIamPolicyResource,
+		NewRepositoryIamBindingResource,
+		NewRepositoryIamMemberResource,
+		NewGenericArtifactResource,
+	}
 }
 
 // ArtifactRegistryProviderModel defines the provider data model.
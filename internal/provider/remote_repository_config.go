@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// remoteRepositoryPresets are the public upstreams a remote repository can proxy, keyed by repository format.
+var remoteRepositoryPresets = map[string]string{
+	"DOCKER": "DOCKER_HUB",
+	"MAVEN":  "MAVEN_CENTRAL",
+	"NPM":    "NPMJS",
+	"PYTHON": "PYPI",
+}
+
+// secretVersionRegex matches the resource name of a Secret Manager secret version.
+var secretVersionRegex = regexp.MustCompile(`^projects/[^/]+/secrets/[^/]+/versions/[^/]+$`)
+
+// RemoteRepositoryConfigModel describes the upstream of a remote repository. The upstream is either a preset or a
+// custom URI, the API keeps it in a field per format.
+type RemoteRepositoryConfigModel struct {
+	Description         types.String              `tfsdk:"description"`
+	UpstreamPreset      types.String              `tfsdk:"upstream_preset"`
+	UpstreamURI         types.String              `tfsdk:"upstream_uri"`
+	UpstreamCredentials *UpstreamCredentialsModel `tfsdk:"upstream_credentials"`
+}
+
+type UpstreamCredentialsModel struct {
+	Username              types.String `tfsdk:"username"`
+	PasswordSecretVersion types.String `tfsdk:"password_secret_version"`
+}
+
+// validateRemoteRepositoryConfig checks the config against the format and mode of the repository. Unknown values are
+// skipped, they are validated once known.
+func validateRemoteRepositoryConfig(attributePath path.Path, format types.String, mode types.String, config *RemoteRepositoryConfigModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !mode.IsUnknown() {
+		remote := mode.ValueString() == repositoryModeRemote
+		if remote && config == nil {
+			diags.AddAttributeError(attributePath, "missing remote_repository_config",
+				fmt.Sprintf("A repository in %s mode requires a remote_repository_config.", repositoryModeRemote))
+		}
+		if !remote && config != nil {
+			diags.AddAttributeError(attributePath, "unexpected remote_repository_config",
+				fmt.Sprintf("remote_repository_config can only be set in %s mode.", repositoryModeRemote))
+		}
+	}
+	if config == nil {
+		return diags
+	}
+
+	preset, supported := remoteRepositoryPresets[format.ValueString()]
+	if !format.IsUnknown() && !supported {
+		formats := make([]string, 0, len(remoteRepositoryPresets))
+		for remoteFormat := range remoteRepositoryPresets {
+			formats = append(formats, remoteFormat)
+		}
+		sort.Strings(formats)
+		diags.AddAttributeError(attributePath, "unsupported remote repository format",
+			fmt.Sprintf("Remote repositories are supported for the formats %s, got %q.", strings.Join(formats, ", "), format.ValueString()))
+	}
+	if !config.UpstreamPreset.IsUnknown() && !config.UpstreamURI.IsUnknown() && config.UpstreamPreset.IsNull() == config.UpstreamURI.IsNull() {
+		diags.AddAttributeError(attributePath, "invalid remote_repository_config",
+			"remote_repository_config must have exactly one of upstream_preset or upstream_uri.")
+	}
+	if !config.UpstreamPreset.IsNull() && !config.UpstreamPreset.IsUnknown() && !format.IsUnknown() && supported &&
+		config.UpstreamPreset.ValueString() != preset {
+		diags.AddAttributeError(attributePath.AtName("upstream_preset"), "invalid upstream_preset",
+			fmt.Sprintf("The upstream_preset of a %s repository must be %s, got %q.", format.ValueString(), preset, config.UpstreamPreset.ValueString()))
+	}
+	if !config.UpstreamURI.IsNull() && !config.UpstreamURI.IsUnknown() && !strings.HasPrefix(config.UpstreamURI.ValueString(), "https://") {
+		diags.AddAttributeError(attributePath.AtName("upstream_uri"), "invalid upstream_uri",
+			fmt.Sprintf("upstream_uri must be an https:// URI, got %q.", config.UpstreamURI.ValueString()))
+	}
+
+	if credentials := config.UpstreamCredentials; credentials != nil {
+		credentialsPath := attributePath.AtName("upstream_credentials")
+		if credentials.Username.IsNull() {
+			diags.AddAttributeError(credentialsPath.AtName("username"), "missing username", "upstream_credentials require a username.")
+		}
+		secretVersion := credentials.PasswordSecretVersion
+		if secretVersion.IsNull() {
+			diags.AddAttributeError(credentialsPath.AtName("password_secret_version"), "missing password_secret_version",
+				"upstream_credentials require a password_secret_version.")
+		} else if !secretVersion.IsUnknown() && !secretVersionRegex.MatchString(secretVersion.ValueString()) {
+			diags.AddAttributeError(credentialsPath.AtName("password_secret_version"), "invalid password_secret_version",
+				fmt.Sprintf("password_secret_version must be a secret version, e.g. projects/<project>/secrets/<secret>/versions/<version>, got %q.", secretVersion.ValueString()))
+		}
+	}
+	return diags
+}
+
+// remoteRepositoryConfigToAPI converts the config, setting the upstream of the format of the repository.
+func remoteRepositoryConfigToAPI(format string, config *RemoteRepositoryConfigModel) *artifactregistrydockerimagesclient.RemoteRepositoryConfig {
+	if config == nil {
+		return nil
+	}
+	apiConfig := &artifactregistrydockerimagesclient.RemoteRepositoryConfig{
+		Description: config.Description.ValueString(),
+	}
+	upstream := &artifactregistrydockerimagesclient.RemoteUpstream{
+		PublicRepository: config.UpstreamPreset.ValueString(),
+	}
+	if !config.UpstreamURI.IsNull() {
+		upstream.CustomRepository = &artifactregistrydockerimagesclient.CustomRepository{URI: config.UpstreamURI.ValueString()}
+	}
+	apiConfig.SetUpstream(format, upstream)
+	if credentials := config.UpstreamCredentials; credentials != nil {
+		apiConfig.UpstreamCredentials = &artifactregistrydockerimagesclient.UpstreamCredentials{
+			UsernamePasswordCredentials: &artifactregistrydockerimagesclient.UsernamePasswordCredentials{
+				Username:              credentials.Username.ValueString(),
+				PasswordSecretVersion: credentials.PasswordSecretVersion.ValueString(),
+			},
+		}
+	}
+	return apiConfig
+}
+
+// remoteRepositoryConfigFromAPI converts the config of the API, reading the upstream of the format of the repository.
+func remoteRepositoryConfigFromAPI(format string, apiConfig *artifactregistrydockerimagesclient.RemoteRepositoryConfig) *RemoteRepositoryConfigModel {
+	if apiConfig == nil {
+		return nil
+	}
+	config := &RemoteRepositoryConfigModel{
+		Description:    stringValueOrNull(apiConfig.Description),
+		UpstreamPreset: types.StringNull(),
+		UpstreamURI:    types.StringNull(),
+	}
+	if upstream := apiConfig.Upstream(format); upstream != nil {
+		config.UpstreamPreset = stringValueOrNull(upstream.PublicRepository)
+		if upstream.CustomRepository != nil {
+			config.UpstreamURI = stringValueOrNull(upstream.CustomRepository.URI)
+		}
+	}
+	if apiConfig.UpstreamCredentials != nil && apiConfig.UpstreamCredentials.UsernamePasswordCredentials != nil {
+		credentials := apiConfig.UpstreamCredentials.UsernamePasswordCredentials
+		config.UpstreamCredentials = &UpstreamCredentialsModel{
+			Username:              types.StringValue(credentials.Username),
+			PasswordSecretVersion: types.StringValue(credentials.PasswordSecretVersion),
+		}
+	}
+	return config
+}
@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strings"
+	"time"
+)
+
+// iamPolicyMaxAttempts is how often a read-modify-write of an IAM policy is attempted before giving up on conflicts.
+const iamPolicyMaxAttempts = 5
+
+// repositoryIamAttributes are the attributes shared by the repository IAM resources.
+func repositoryIamAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"repository": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the repository. Defaults to the repository of the provider.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"location": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The location of the repository. Defaults to the location of the provider.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"etag": schema.StringAttribute{
+			Computed:    true,
+			Description: "The etag of the IAM policy of the repository.",
+		},
+	}
+}
+
+// repositoryIamDefaults fills in the location and repository of the provider when they are not configured, and returns
+// the resource name of the repository.
+func repositoryIamDefaults(client *artifactregistrydockerimagesclient.Client, location *types.String, repository *types.String) string {
+	if location.IsNull() || location.IsUnknown() {
+		*location = types.StringValue(client.Location)
+	}
+	if repository.IsNull() || repository.IsUnknown() {
+		*repository = types.StringValue(client.Repository)
+	}
+	return client.RepositoryName(location.ValueString(), repository.ValueString())
+}
+
+// parseRepositoryIamID splits an ID of the form "<repository name>[ <role>[ <member>]][ <condition title>]" into the
+// location, the repository ID and the remaining space separated fields. When conditional is set, everything after the
+// fields is returned as an additional field holding the title of the condition, which may contain spaces.
+func parseRepositoryIamID(id string, fields int, conditional bool) (location string, repository string, rest []string, err error) {
+	parts := strings.Split(id, " ")
+	if conditional {
+		parts = strings.SplitN(id, " ", fields+2)
+	}
+	segments := strings.Split(parts[0], "/")
+	if len(parts) < fields+1 || len(parts) > fields+2 || (!conditional && len(parts) != fields+1) ||
+		len(segments) != 6 || segments[0] != "projects" || segments[2] != "locations" || segments[4] != "repositories" {
+		return "", "", nil, fmt.Errorf("expected projects/<project>/locations/<location>/repositories/<repository_id> followed by %d space separated fields, got %q", fields, id)
+	}
+	return segments[3], segments[5], parts[1:], nil
+}
+
+// repositoryIamID is the inverse of parseRepositoryIamID.
+func repositoryIamID(repositoryName string, condition *artifactregistrydockerimagesclient.Expr, fields ...string) string {
+	parts := append([]string{repositoryName}, fields...)
+	if condition != nil {
+		parts = append(parts, condition.Title)
+	}
+	return strings.Join(parts, " ")
+}
+
+// modifyRepositoryIamPolicy reads the IAM policy of the repository, applies modify and writes it back. The etag of the
+// read policy guards against concurrent modifications, on conflict the whole read-modify-write is retried.
+func modifyRepositoryIamPolicy(ctx context.Context, client *artifactregistrydockerimagesclient.Client, repositoryName string, modify func(policy *artifactregistrydockerimagesclient.Policy) error) (*artifactregistrydockerimagesclient.Policy, error) {
+	var err error
+	for attempt := 1; attempt <= iamPolicyMaxAttempts; attempt++ {
+		var policy *artifactregistrydockerimagesclient.Policy
+		policy, err = client.GetIamPolicy(ctx, repositoryName)
+		if err != nil {
+			return nil, err
+		}
+		if err = modify(policy); err != nil {
+			return nil, err
+		}
+		var updated *artifactregistrydockerimagesclient.Policy
+		updated, err = client.SetIamPolicy(ctx, repositoryName, policy)
+		if err == nil {
+			return updated, nil
+		}
+		if !artifactregistrydockerimagesclient.IsConflict(err) {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Duration(attempt) * time.Second):
+		}
+	}
+	return nil, fmt.Errorf("IAM policy of %s was concurrently modified %d times: %w", repositoryName, iamPolicyMaxAttempts, err)
+}
+
+// findBinding returns the binding of role with the same condition, or nil. A condition without an expression, as set
+// by an import, matches the condition with the same title.
+func findBinding(policy *artifactregistrydockerimagesclient.Policy, role string, condition *artifactregistrydockerimagesclient.Expr) *artifactregistrydockerimagesclient.Binding {
+	for i, binding := range policy.Bindings {
+		if binding.Role != role || (binding.Condition == nil) != (condition == nil) {
+			continue
+		}
+		if condition == nil || *binding.Condition == *condition ||
+			(condition.Expression == "" && binding.Condition.Title == condition.Title) {
+			return &policy.Bindings[i]
+		}
+	}
+	return nil
+}
+
+// bindingMembers returns the members the policy grants role to under the condition.
+func bindingMembers(policy *artifactregistrydockerimagesclient.Policy, role string, condition *artifactregistrydockerimagesclient.Expr) []string {
+	if binding := findBinding(policy, role, condition); binding != nil {
+		return binding.Members
+	}
+	return nil
+}
+
+// setBindingMembers replaces the members the policy grants role to under the condition, removing the binding when
+// members is empty. Bindings of the same role with other conditions are left untouched.
+func setBindingMembers(policy *artifactregistrydockerimagesclient.Policy, role string, condition *artifactregistrydockerimagesclient.Expr, members []string) {
+	existing := findBinding(policy, role, condition)
+	if existing != nil {
+		condition = existing.Condition
+	}
+	bindings := make([]artifactregistrydockerimagesclient.Binding, 0, len(policy.Bindings)+1)
+	for i := range policy.Bindings {
+		if &policy.Bindings[i] != existing {
+			bindings = append(bindings, policy.Bindings[i])
+		}
+	}
+	if len(members) > 0 {
+		bindings = append(bindings, artifactregistrydockerimagesclient.Binding{Role: role, Members: members, Condition: condition})
+	}
+	policy.Bindings = bindings
+}
+
+// addBindingMember grants role to member under the condition, keeping the other members of the binding.
+func addBindingMember(policy *artifactregistrydockerimagesclient.Policy, role string, condition *artifactregistrydockerimagesclient.Expr, member string) {
+	members := bindingMembers(policy, role, condition)
+	if !contains(members, member) {
+		setBindingMembers(policy, role, condition, append(append([]string{}, members...), member))
+	}
+}
+
+// removeBindingMember revokes role under the condition from member, keeping the other members of the binding.
+func removeBindingMember(policy *artifactregistrydockerimagesclient.Policy, role string, condition *artifactregistrydockerimagesclient.Expr, member string) {
+	var members []string
+	for _, m := range bindingMembers(policy, role, condition) {
+		if m != member {
+			members = append(members, m)
+		}
+	}
+	setBindingMembers(policy, role, condition, members)
+}
+
+// normalizeBindings merges bindings of the same role and condition, and sorts bindings and members, so that policies
+// can be compared.
+func normalizeBindings(bindings []artifactregistrydockerimagesclient.Binding) []artifactregistrydockerimagesclient.Binding {
+	policy := &artifactregistrydockerimagesclient.Policy{}
+	for _, binding := range bindings {
+		for _, member := range binding.Members {
+			addBindingMember(policy, binding.Role, binding.Condition, member)
+		}
+	}
+	normalized := append(make([]artifactregistrydockerimagesclient.Binding, 0, len(policy.Bindings)), policy.Bindings...)
+	for _, binding := range normalized {
+		sort.Strings(binding.Members)
+	}
+	sort.Slice(normalized, func(i, j int) bool {
+		if normalized[i].Role != normalized[j].Role {
+			return normalized[i].Role < normalized[j].Role
+		}
+		return conditionTitle(normalized[i].Condition) < conditionTitle(normalized[j].Condition)
+	})
+	return normalized
+}
+
+func conditionTitle(condition *artifactregistrydockerimagesclient.Expr) string {
+	if condition == nil {
+		return ""
+	}
+	return condition.Title
+}
+
+// IamConditionModel is the condition block of the repository IAM resources.
+type IamConditionModel struct {
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Expression  types.String `tfsdk:"expression"`
+}
+
+// repositoryIamConditionBlock is the condition block of the repository IAM resources. Changing the condition replaces
+// the binding, as the condition is part of what identifies it.
+func repositoryIamConditionBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "Only grant the role while the condition holds, e.g. until a point in time or for tags with a prefix.",
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"title": schema.StringAttribute{
+				Optional:    true,
+				Description: "The title of the condition, required when the block is set.",
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"expression": schema.StringAttribute{
+				Optional: true,
+				Description: "The CEL expression of the condition, required when the block is set, " +
+					"e.g. request.time < timestamp(\"2024-01-01T00:00:00Z\").",
+			},
+		},
+	}
+}
+
+// validateIamCondition checks that a configured condition has a title and an expression.
+func validateIamCondition(conditionPath path.Path, condition *IamConditionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if condition == nil {
+		return diags
+	}
+	if condition.Title.IsNull() {
+		diags.AddAttributeError(conditionPath.AtName("title"), "missing title", "A condition requires a title.")
+	}
+	if condition.Expression.IsNull() {
+		diags.AddAttributeError(conditionPath.AtName("expression"), "missing expression", "A condition requires an expression.")
+	}
+	return diags
+}
+
+func iamConditionFromModel(condition *IamConditionModel) *artifactregistrydockerimagesclient.Expr {
+	if condition == nil {
+		return nil
+	}
+	return &artifactregistrydockerimagesclient.Expr{
+		Title:       condition.Title.ValueString(),
+		Description: condition.Description.ValueString(),
+		Expression:  condition.Expression.ValueString(),
+	}
+}
+
+func iamConditionToModel(condition *artifactregistrydockerimagesclient.Expr) *IamConditionModel {
+	if condition == nil {
+		return nil
+	}
+	return &IamConditionModel{
+		Title:       types.StringValue(condition.Title),
+		Description: stringValueOrNull(condition.Description),
+		Expression:  types.StringValue(condition.Expression),
+	}
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+)
+
+func TestBindingMembers(t *testing.T) {
+	policy := &artifactregistrydockerimagesclient.Policy{
+		Bindings: []artifactregistrydockerimagesclient.Binding{
+			{Role: "roles/artifactregistry.reader", Members: []string{"user:a@example.com"}},
+			{Role: "roles/artifactregistry.writer", Members: []string{"user:b@example.com"}},
+		},
+	}
+
+	addBindingMember(policy, "roles/artifactregistry.reader", nil, "user:c@example.com")
+	addBindingMember(policy, "roles/artifactregistry.reader", nil, "user:c@example.com")
+	if members := bindingMembers(policy, "roles/artifactregistry.reader", nil); !reflect.DeepEqual(members, []string{"user:a@example.com", "user:c@example.com"}) {
+		t.Errorf("unexpected reader members %v", members)
+	}
+
+	removeBindingMember(policy, "roles/artifactregistry.writer", nil, "user:b@example.com")
+	if len(policy.Bindings) != 1 {
+		t.Errorf("expected the empty writer binding to be removed, got %v", policy.Bindings)
+	}
+
+	setBindingMembers(policy, "roles/artifactregistry.admin", nil, []string{"user:d@example.com"})
+	expected := []artifactregistrydockerimagesclient.Binding{
+		{Role: "roles/artifactregistry.admin", Members: []string{"user:d@example.com"}},
+		{Role: "roles/artifactregistry.reader", Members: []string{"user:a@example.com", "user:c@example.com"}},
+	}
+	if normalized := normalizeBindings(policy.Bindings); !reflect.DeepEqual(normalized, expected) {
+		t.Errorf("unexpected bindings %v", normalized)
+	}
+}
+
+func TestConditionalBindingMembers(t *testing.T) {
+	untilNewYear := &artifactregistrydockerimagesclient.Expr{Title: "until-new-year", Expression: `request.time < timestamp("2024-01-01T00:00:00Z")`}
+	releaseTags := &artifactregistrydockerimagesclient.Expr{Title: "release-tags", Expression: `resource.name.extract("tags/{tag}").startsWith("release-")`}
+	policy := &artifactregistrydockerimagesclient.Policy{
+		Bindings: []artifactregistrydockerimagesclient.Binding{
+			{Role: "roles/artifactregistry.reader", Members: []string{"user:a@example.com"}},
+			{Role: "roles/artifactregistry.reader", Members: []string{"user:b@example.com"}, Condition: untilNewYear},
+		},
+	}
+
+	// Bindings of the same role with different conditions are managed independently.
+	addBindingMember(policy, "roles/artifactregistry.reader", releaseTags, "user:c@example.com")
+	addBindingMember(policy, "roles/artifactregistry.reader", untilNewYear, "user:c@example.com")
+	setBindingMembers(policy, "roles/artifactregistry.reader", nil, []string{"user:d@example.com"})
+	expected := map[string][]string{
+		"":               {"user:d@example.com"},
+		"until-new-year": {"user:b@example.com", "user:c@example.com"},
+		"release-tags":   {"user:c@example.com"},
+	}
+	if len(policy.Bindings) != len(expected) {
+		t.Fatalf("expected %d bindings, got %v", len(expected), policy.Bindings)
+	}
+	for _, binding := range policy.Bindings {
+		if members := expected[conditionTitle(binding.Condition)]; !reflect.DeepEqual(binding.Members, members) {
+			t.Errorf("%s: expected %v, got %v", conditionTitle(binding.Condition), members, binding.Members)
+		}
+	}
+
+	// A condition with only a title, as set by an import, matches the condition with that title.
+	if members := bindingMembers(policy, "roles/artifactregistry.reader", &artifactregistrydockerimagesclient.Expr{Title: "release-tags"}); len(members) != 1 {
+		t.Errorf("expected the imported condition to match, got %v", members)
+	}
+	changed := &artifactregistrydockerimagesclient.Expr{Title: "release-tags", Expression: "true"}
+	if members := bindingMembers(policy, "roles/artifactregistry.reader", changed); members != nil {
+		t.Errorf("expected a changed expression not to match, got %v", members)
+	}
+
+	removeBindingMember(policy, "roles/artifactregistry.reader", releaseTags, "user:c@example.com")
+	if len(policy.Bindings) != 2 {
+		t.Errorf("expected the empty conditional binding to be removed, got %v", policy.Bindings)
+	}
+}
+
+func TestParseRepositoryIamID(t *testing.T) {
+	location, repository, rest, err := parseRepositoryIamID("projects/devops-339608/locations/europe/repositories/services roles/artifactregistry.reader", 1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if location != "europe" || repository != "services" || !reflect.DeepEqual(rest, []string{"roles/artifactregistry.reader"}) {
+		t.Errorf("unexpected result %s %s %v", location, repository, rest)
+	}
+	_, _, rest, err = parseRepositoryIamID("projects/devops-339608/locations/europe/repositories/services roles/artifactregistry.reader until new year", 1, true)
+	if err != nil || !reflect.DeepEqual(rest, []string{"roles/artifactregistry.reader", "until new year"}) {
+		t.Errorf("unexpected conditional result %v %v", rest, err)
+	}
+	if _, _, _, err := parseRepositoryIamID("projects/devops-339608/locations/europe/repositories/services", 1, true); err == nil {
+		t.Error("expected a missing role to be invalid")
+	}
+	if _, _, _, err := parseRepositoryIamID("projects/devops-339608/locations/europe/repositories/services roles/artifactregistry.reader", 0, false); err == nil {
+		t.Error("expected a role to be invalid for a policy")
+	}
+}
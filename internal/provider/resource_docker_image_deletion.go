@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+)
+
+const (
+	deleteOnCreate  = "create"
+	deleteOnDestroy = "destroy"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DockerImageDeletionResource{}
+var _ resource.ResourceWithConfigure = &DockerImageDeletionResource{}
+var _ resource.ResourceWithValidateConfig = &DockerImageDeletionResource{}
+
+func NewDockerImageDeletionResource() resource.Resource {
+	return &DockerImageDeletionResource{}
+}
+
+// DockerImageDeletionResource deletes a version or a whole package when it is created or destroyed.
+type DockerImageDeletionResource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// DockerImageDeletionResourceModel defines the resource model.
+type DockerImageDeletionResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Package           types.String `tfsdk:"package"`
+	Version           types.String `tfsdk:"version"`
+	DeleteOn          types.String `tfsdk:"delete_on"`
+	ProtectedTagRegex types.String `tfsdk:"protected_tag_regex"`
+}
+
+func (r *DockerImageDeletionResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_docker_image_deletion"
+}
+
+func (r *DockerImageDeletionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DockerImageDeletionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This resource deletes a version (digest) of a package, or the whole package, when it is created or destroyed. " +
+			"Versions carrying a protected tag are never deleted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the package, e.g. campaign-service.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Optional:    true,
+				Description: "The version to delete, which is the digest for docker images. The whole package is deleted when omitted.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delete_on": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(deleteOnCreate),
+				Description: "Whether to delete on \"create\" or on \"destroy\" of the resource. Defaults to \"create\".",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"protected_tag_regex": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(".*"),
+				Description: "Versions carrying a tag matching this regular expression are never deleted, and neither are packages " +
+					"containing such versions. Defaults to \".*\", protecting every tagged version.",
+			},
+		},
+	}
+}
+
+func (r *DockerImageDeletionResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data DockerImageDeletionResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DeleteOn.IsNull() && !data.DeleteOn.IsUnknown() {
+		if deleteOn := data.DeleteOn.ValueString(); deleteOn != deleteOnCreate && deleteOn != deleteOnDestroy {
+			response.Diagnostics.AddAttributeError(path.Root("delete_on"), "invalid delete_on",
+				fmt.Sprintf("delete_on must be %q or %q, got %q", deleteOnCreate, deleteOnDestroy, deleteOn))
+		}
+	}
+	if !data.ProtectedTagRegex.IsNull() && !data.ProtectedTagRegex.IsUnknown() {
+		if _, err := regexp.Compile(data.ProtectedTagRegex.ValueString()); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("protected_tag_regex"), "invalid protected_tag_regex", err.Error())
+		}
+	}
+}
+
+func (r *DockerImageDeletionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data DockerImageDeletionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.Package.ValueString())
+	if !data.Version.IsNull() {
+		data.ID = types.StringValue(fmt.Sprintf("%s@%s", data.Package.ValueString(), data.Version.ValueString()))
+	}
+	if data.DeleteOn.ValueString() == deleteOnCreate {
+		response.Diagnostics.Append(r.delete(ctx, &data)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// Read keeps the state as is: once deleted there is nothing left to read, and a target that is still to be deleted
+// on destroy is allowed to change.
+func (r *DockerImageDeletionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+}
+
+func (r *DockerImageDeletionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data DockerImageDeletionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *DockerImageDeletionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data DockerImageDeletionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeleteOn.ValueString() == deleteOnDestroy {
+		response.Diagnostics.Append(r.delete(ctx, &data)...)
+	}
+}
+
+// delete deletes the version, or the package when no version is set, after checking that it carries no protected tag.
+// Targets that no longer exist are considered deleted.
+func (r *DockerImageDeletionResource) delete(ctx context.Context, data *DockerImageDeletionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	packageID := data.Package.ValueString()
+	protectedTagRegex, err := regexp.Compile(data.ProtectedTagRegex.ValueString())
+	if err != nil {
+		diags.AddError("invalid protected_tag_regex", err.Error())
+		return diags
+	}
+
+	tags, err := r.client.ListTags(ctx, packageID)
+	if artifactregistrydockerimagesclient.IsNotFound(err) {
+		return diags
+	}
+	if err != nil {
+		diags.AddError("failed to list tags", err.Error())
+		return diags
+	}
+	for _, tag := range tags {
+		if !data.Version.IsNull() && tag.VersionID() != data.Version.ValueString() {
+			continue
+		}
+		if protectedTagRegex.MatchString(tag.ID()) {
+			diags.AddError("refusing to delete protected version",
+				fmt.Sprintf("Version %s of package %s carries the protected tag %q.", tag.VersionID(), packageID, tag.ID()))
+			return diags
+		}
+	}
+
+	var operation *artifactregistrydockerimagesclient.Operation
+	if data.Version.IsNull() {
+		operation, err = r.client.DeletePackage(ctx, packageID)
+	} else {
+		operation, err = r.client.DeleteVersion(ctx, packageID, data.Version.ValueString())
+	}
+	if err == nil {
+		_, err = r.client.WaitForOperation(ctx, operation)
+	}
+	if err != nil && !artifactregistrydockerimagesclient.IsNotFound(err) {
+		diags.AddError(fmt.Sprintf("failed to delete %s", data.ID.ValueString()), err.Error())
+	}
+	return diags
+}
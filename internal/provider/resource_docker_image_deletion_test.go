@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDockerImageDeletionResource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+data "artifactregistry_tags" "test" {
+	package = "campaign-service"
+}
+resource "artifactregistry_docker_image_deletion" "test" {
+	package = "campaign-service"
+	version = data.artifactregistry_tags.test.tag_versions["development-9681cde"]
+	protected_tag_regex = "^development-"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("refusing to delete protected version"),
+			},
+		},
+	})
+}
@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"io"
+	"os"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GenericArtifactResource{}
+var _ resource.ResourceWithConfigure = &GenericArtifactResource{}
+var _ resource.ResourceWithModifyPlan = &GenericArtifactResource{}
+
+func NewGenericArtifactResource() resource.Resource {
+	return &GenericArtifactResource{}
+}
+
+// GenericArtifactResource uploads a local file as a generic artifact.
+type GenericArtifactResource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// GenericArtifactResourceModel defines the resource model.
+type GenericArtifactResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Package    types.String `tfsdk:"package"`
+	Version    types.String `tfsdk:"version"`
+	Filename   types.String `tfsdk:"filename"`
+	Source     types.String `tfsdk:"source"`
+	SHA256     types.String `tfsdk:"sha256"`
+	SizeBytes  types.Int64  `tfsdk:"size_bytes"`
+	CreateTime types.String `tfsdk:"create_time"`
+	UpdateTime types.String `tfsdk:"update_time"`
+}
+
+func (r *GenericArtifactResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_generic_artifact"
+}
+
+func (r *GenericArtifactResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GenericArtifactResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This resource uploads a local file into a generic repository as the file of a package version. " +
+			"The artifact is replaced when the local file or the uploaded file changes, and deleted on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The resource name of the uploaded file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the package, e.g. charts.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the version, e.g. 1.4.0.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filename": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the file in the version, e.g. campaign-service-1.4.0.tgz.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Required:    true,
+				Description: "The path of the local file to upload.",
+			},
+			"sha256": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "The hex encoded SHA256 hash of the artifact. It is computed from source when planning, " +
+					"when set the plan fails unless source has this hash.",
+			},
+			"size_bytes": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"update_time": schema.StringAttribute{
+				Computed: true,
+				// Moving the source is the only in-place update, and it does not touch the uploaded file.
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan hashes the source so that a changed local file, or an uploaded file whose hash drifted from it, replaces
+// the artifact.
+func (r *GenericArtifactResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// The plan is null when the artifact is destroyed.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+	var source, configuredSHA256 types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("source"), &source)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("sha256"), &configuredSHA256)...)
+	var stateSHA256 types.String
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("sha256"), &stateSHA256)...)
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plannedSHA256 := types.StringUnknown()
+	if !source.IsUnknown() {
+		sha256Hash, err := fileSHA256(source.ValueString())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("source"), "failed to hash source", err.Error())
+			return
+		}
+		if !configuredSHA256.IsNull() && !configuredSHA256.IsUnknown() && configuredSHA256.ValueString() != sha256Hash {
+			response.Diagnostics.AddAttributeError(path.Root("sha256"), "source does not match sha256",
+				fmt.Sprintf("%s has the lowercase hex encoded SHA256 hash %s, expected %s.", source.ValueString(), sha256Hash, configuredSHA256.ValueString()))
+			return
+		}
+		plannedSHA256 = types.StringValue(sha256Hash)
+	}
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("sha256"), plannedSHA256)...)
+	if !request.State.Raw.IsNull() && (plannedSHA256.IsUnknown() || plannedSHA256.ValueString() != stateSHA256.ValueString()) {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root("sha256"))
+	}
+}
+
+func (r *GenericArtifactResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data GenericArtifactResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	source, err := os.Open(data.Source.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("source"), "failed to open source", err.Error())
+		return
+	}
+	defer source.Close()
+	// The hash of what was actually uploaded, the file may have changed since it was hashed when planning.
+	hash := sha256.New()
+	operation, err := r.client.UploadGenericArtifact(ctx, data.Package.ValueString(), data.Version.ValueString(), data.Filename.ValueString(),
+		io.TeeReader(source, hash))
+	if err == nil {
+		_, err = r.client.WaitForOperation(ctx, operation)
+	}
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to upload generic artifact", err.Error()))
+		return
+	}
+
+	fileID := artifactregistrydockerimagesclient.GenericArtifactFileID(data.Package.ValueString(), data.Version.ValueString(), data.Filename.ValueString())
+	file, err := r.client.GetFile(ctx, fileID)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to read uploaded generic artifact", err.Error()))
+		return
+	}
+	uploadedSHA256 := hex.EncodeToString(hash.Sum(nil))
+	if verificationErr := verifyGenericArtifact(data.SHA256.ValueString(), uploadedSHA256, file.SHA256()); verificationErr != nil {
+		response.Diagnostics.AddError("failed to verify generic artifact", verificationErr.Error())
+		// Remove the unverified upload, otherwise the next apply fails because the file already exists.
+		if operation, err := r.client.DeleteFile(ctx, fileID); err == nil {
+			_, _ = r.client.WaitForOperation(ctx, operation)
+		}
+		return
+	}
+	response.Diagnostics.Append(genericArtifactToModel(file, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *GenericArtifactResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data GenericArtifactResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	fileID := artifactregistrydockerimagesclient.GenericArtifactFileID(data.Package.ValueString(), data.Version.ValueString(), data.Filename.ValueString())
+	file, err := r.client.GetFile(ctx, fileID)
+	if artifactregistrydockerimagesclient.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to read generic artifact", err.Error()))
+		return
+	}
+	response.Diagnostics.Append(genericArtifactToModel(file, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// Update only moves the source, any change of its content replaces the artifact.
+func (r *GenericArtifactResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data GenericArtifactResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *GenericArtifactResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data GenericArtifactResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	fileID := artifactregistrydockerimagesclient.GenericArtifactFileID(data.Package.ValueString(), data.Version.ValueString(), data.Filename.ValueString())
+	operation, err := r.client.DeleteFile(ctx, fileID)
+	if err == nil {
+		_, err = r.client.WaitForOperation(ctx, operation)
+	}
+	if err != nil && !artifactregistrydockerimagesclient.IsNotFound(err) {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to delete generic artifact", err.Error()))
+	}
+}
+
+// verifyGenericArtifact checks that the uploaded content is the one hashed when planning, and that the API stored it
+// unaltered.
+func verifyGenericArtifact(plannedSHA256 string, uploadedSHA256 string, storedSHA256 string) error {
+	if plannedSHA256 != "" && plannedSHA256 != uploadedSHA256 {
+		return fmt.Errorf("the source changed since it was planned, its SHA256 hash was %s but %s was uploaded", plannedSHA256, uploadedSHA256)
+	}
+	if uploadedSHA256 != storedSHA256 {
+		return fmt.Errorf("the uploaded SHA256 hash %s does not match the stored SHA256 hash %q", uploadedSHA256, storedSHA256)
+	}
+	return nil
+}
+
+// genericArtifactToModel copies the file into data. The sha256 is the one of the stored file, so drift replaces the
+// artifact on the next plan.
+func genericArtifactToModel(file *artifactregistrydockerimagesclient.File, data *GenericArtifactResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	data.ID = types.StringValue(file.Name)
+	data.SHA256 = types.StringValue(file.SHA256())
+	data.CreateTime = types.StringValue(file.CreateTime)
+	data.UpdateTime = types.StringValue(file.UpdateTime)
+	sizeBytes, err := parseInt64String(file.SizeBytes)
+	if err != nil {
+		diags.AddError("invalid file size", err.Error())
+		return diags
+	}
+	data.SizeBytes = types.Int64Value(sizeBytes)
+	return diags
+}
+
+// fileSHA256 returns the hex encoded SHA256 hash of the file at name.
+func fileSHA256(name string) (string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGenericArtifactResource(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "artifact.txt")
+	movedSource := filepath.Join(t.TempDir(), "moved.txt")
+	for _, name := range []string{source, movedSource} {
+		if err := os.WriteFile(name, []byte("hello"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	config := func(source string) string {
+		return `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "generic"
+}
+resource "artifactregistry_generic_artifact" "test" {
+	package = "terraform-provider-acceptance-test"
+	version = "1.0.0"
+	filename = "artifact.txt"
+	source = "` + filepath.ToSlash(source) + `"
+}
+`
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("artifactregistry_generic_artifact.test", "sha256", "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"),
+					resource.TestCheckResourceAttr("artifactregistry_generic_artifact.test", "size_bytes", "5"),
+				),
+			},
+			// Moving the source without changing its content updates the artifact in place.
+			{
+				Config: config(movedSource),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("artifactregistry_generic_artifact.test", "source", filepath.ToSlash(movedSource)),
+					resource.TestCheckResourceAttr("artifactregistry_generic_artifact.test", "size_bytes", "5"),
+					resource.TestCheckResourceAttrSet("artifactregistry_generic_artifact.test", "update_time"),
+				),
+			},
+		},
+	})
+}
+
+func TestVerifyGenericArtifact(t *testing.T) {
+	hash := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	other := "486ea46224d1bb4fb680f34f7c9ad96a8f24ec88be73ea8e5a6c65260e9cb8a7"
+	if err := verifyGenericArtifact(hash, hash, hash); err != nil {
+		t.Errorf("expected matching hashes to verify, got %v", err)
+	}
+	if err := verifyGenericArtifact(other, hash, hash); err == nil {
+		t.Error("expected a source changed since planning to fail")
+	}
+	if err := verifyGenericArtifact(hash, hash, other); err == nil {
+		t.Error("expected a stored hash mismatch to fail")
+	}
+	if err := verifyGenericArtifact(hash, hash, ""); err == nil {
+		t.Error("expected a missing stored hash to fail")
+	}
+}
+
+func TestFileSHA256(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "artifact.txt")
+	if err := os.WriteFile(name, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := fileSHA256(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash != "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824" {
+		t.Errorf("unexpected hash %s", hash)
+	}
+}
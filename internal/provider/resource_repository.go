@@ -0,0 +1,552 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+const (
+	repositoryModeStandard = "STANDARD_REPOSITORY"
+	repositoryModeRemote   = "REMOTE_REPOSITORY"
+	repositoryModeVirtual  = "VIRTUAL_REPOSITORY"
+)
+
+// repositoryFormats are the formats a repository can be created with.
+var repositoryFormats = []string{"DOCKER", "MAVEN", "NPM", "PYTHON", "APT", "YUM", "GO", "GENERIC", "KFP"}
+
+// repositoryUpdateMask lists the fields of a repository that are updated in place, all others require a replacement.
+var repositoryUpdateMask = []string{"description", "labels", "cleanupPolicies", "cleanupPolicyDryRun"}
+
+// remoteRepositoryUpdateMask lists the fields of the remote_repository_config that are updated in place.
+var remoteRepositoryUpdateMask = []string{"remoteRepositoryConfig.description", "remoteRepositoryConfig.upstreamCredentials"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RepositoryResource{}
+var _ resource.ResourceWithConfigure = &RepositoryResource{}
+var _ resource.ResourceWithValidateConfig = &RepositoryResource{}
+var _ resource.ResourceWithImportState = &RepositoryResource{}
+var _ resource.ResourceWithModifyPlan = &RepositoryResource{}
+
+func NewRepositoryResource() resource.Resource {
+	return &RepositoryResource{}
+}
+
+// RepositoryResource manages an Artifact Registry repository.
+type RepositoryResource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// RepositoryResourceModel defines the resource model.
+type RepositoryResourceModel struct {
+	ID                      types.String                  `tfsdk:"id"`
+	RepositoryID            types.String                  `tfsdk:"repository_id"`
+	Location                types.String                  `tfsdk:"location"`
+	Format                  types.String                  `tfsdk:"format"`
+	Mode                    types.String                  `tfsdk:"mode"`
+	Description             types.String                  `tfsdk:"description"`
+	Labels                  types.Map                     `tfsdk:"labels"`
+	KmsKeyName              types.String                  `tfsdk:"kms_key_name"`
+	CleanupPolicyDryRun     types.Bool                    `tfsdk:"cleanup_policy_dry_run"`
+	CleanupPolicies         []CleanupPolicyModel          `tfsdk:"cleanup_policies"`
+	RemoteRepositoryConfig  *RemoteRepositoryConfigModel  `tfsdk:"remote_repository_config"`
+	VirtualRepositoryConfig *VirtualRepositoryConfigModel `tfsdk:"virtual_repository_config"`
+	CreateTime              types.String                  `tfsdk:"create_time"`
+	UpdateTime              types.String                  `tfsdk:"update_time"`
+}
+
+func (r *RepositoryResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_repository"
+}
+
+func (r *RepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RepositoryResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This resource manages an Artifact Registry repository of the project, including its cleanup policies, " +
+			"the upstream of remote repositories and the upstreams of virtual repositories.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The resource name of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"location": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The location of the repository. Defaults to the location of the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"format": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("The format of the repository, one of %s.", strings.Join(repositoryFormats, ", ")),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(repositoryModeStandard),
+				Description: fmt.Sprintf("The mode of the repository, one of %s, %s or %s. Defaults to %s.",
+					repositoryModeStandard, repositoryModeRemote, repositoryModeVirtual, repositoryModeStandard),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"kms_key_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Cloud KMS key used to encrypt the contents of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cleanup_policy_dry_run": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the cleanup policies only log what they would delete instead of deleting it.",
+			},
+			"create_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"update_time": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cleanup_policies": schema.SetNestedBlock{
+				Description: "The cleanup policies of the repository. KEEP policies take precedence over DELETE policies.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required: true,
+						},
+						"action": schema.StringAttribute{
+							Required:    true,
+							Description: "Either DELETE or KEEP.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"condition": schema.SingleNestedBlock{
+							Description: "The versions the policy applies to.",
+							Attributes: map[string]schema.Attribute{
+								"tag_state": schema.StringAttribute{
+									Optional:    true,
+									Description: "One of TAGGED, UNTAGGED or ANY.",
+								},
+								"tag_prefixes": schema.ListAttribute{
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+								"version_name_prefixes": schema.ListAttribute{
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+								"package_name_prefixes": schema.ListAttribute{
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+								"older_than": schema.StringAttribute{
+									Optional:    true,
+									Description: "Only match versions older than this duration in seconds, e.g. 2592000s.",
+								},
+								"newer_than": schema.StringAttribute{
+									Optional:    true,
+									Description: "Only match versions newer than this duration in seconds, e.g. 2592000s.",
+								},
+							},
+						},
+						"most_recent_versions": schema.SingleNestedBlock{
+							Description: "Keep the most recent versions of the matching packages. Only valid with the KEEP action.",
+							Attributes: map[string]schema.Attribute{
+								"package_name_prefixes": schema.ListAttribute{
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+								"keep_count": schema.Int64Attribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"remote_repository_config": schema.SingleNestedBlock{
+				Description: fmt.Sprintf("The upstream proxied by the repository, required in %s mode.", repositoryModeRemote),
+				Attributes: map[string]schema.Attribute{
+					"description": schema.StringAttribute{
+						Optional: true,
+					},
+					"upstream_preset": schema.StringAttribute{
+						Optional:    true,
+						Description: "The public upstream of the format: DOCKER_HUB, MAVEN_CENTRAL, NPMJS or PYPI. Conflicts with upstream_uri.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"upstream_uri": schema.StringAttribute{
+						Optional:    true,
+						Description: "The https:// URI of a custom upstream. Conflicts with upstream_preset.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"upstream_credentials": schema.SingleNestedBlock{
+						Description: "The credentials to authenticate with the upstream.",
+						Attributes: map[string]schema.Attribute{
+							"username": schema.StringAttribute{
+								Optional: true,
+							},
+							"password_secret_version": schema.StringAttribute{
+								Optional: true,
+								Description: "The Secret Manager secret version holding the password, " +
+									"e.g. projects/devops-339608/secrets/docker-hub/versions/1.",
+							},
+						},
+					},
+				},
+			},
+			"virtual_repository_config": schema.SingleNestedBlock{
+				Description: fmt.Sprintf("The upstreams served by the repository, required in %s mode.", repositoryModeVirtual),
+				Blocks: map[string]schema.Block{
+					"upstream_policies": schema.ListNestedBlock{
+						Description: "The upstream repositories, which must have the format and location of the repository.",
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Required: true,
+								},
+								"repository": schema.StringAttribute{
+									Required:    true,
+									Description: "The resource name of the upstream, e.g. projects/devops-339608/locations/europe/repositories/services.",
+								},
+								"priority": schema.Int64Attribute{
+									Required:    true,
+									Description: "The priority of the upstream, upstreams with a higher priority are searched first.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *RepositoryResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var format, mode types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("format"), &format)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("mode"), &mode)...)
+	var policies types.Set
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("cleanup_policies"), &policies)...)
+	var remoteConfig *RemoteRepositoryConfigModel
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("remote_repository_config"), &remoteConfig)...)
+	var virtualConfig *VirtualRepositoryConfigModel
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("virtual_repository_config"), &virtualConfig)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !format.IsNull() && !format.IsUnknown() && !contains(repositoryFormats, format.ValueString()) {
+		response.Diagnostics.AddAttributeError(path.Root("format"), "invalid format",
+			fmt.Sprintf("format must be one of %s, got %q.", strings.Join(repositoryFormats, ", "), format.ValueString()))
+	}
+	modes := []string{repositoryModeStandard, repositoryModeRemote, repositoryModeVirtual}
+	if !mode.IsNull() && !mode.IsUnknown() && !contains(modes, mode.ValueString()) {
+		response.Diagnostics.AddAttributeError(path.Root("mode"), "invalid mode",
+			fmt.Sprintf("mode must be one of %s, got %q.", strings.Join(modes, ", "), mode.ValueString()))
+	}
+	// An unset mode defaults to STANDARD_REPOSITORY.
+	if mode.IsNull() {
+		mode = types.StringValue(repositoryModeStandard)
+	}
+	response.Diagnostics.Append(validateRemoteRepositoryConfig(path.Root("remote_repository_config"), format, mode, remoteConfig)...)
+	response.Diagnostics.Append(validateVirtualRepositoryConfig(path.Root("virtual_repository_config"), mode, virtualConfig)...)
+
+	if policies.IsUnknown() {
+		return
+	}
+	var policyModels []CleanupPolicyModel
+	response.Diagnostics.Append(policies.ElementsAs(ctx, &policyModels, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(validateCleanupPolicies(path.Root("cleanup_policies"), policyModels)...)
+}
+
+// ModifyPlan defaults the location to the one of the provider, and reads the upstreams of a virtual repository to
+// check their format and location, which ValidateConfig cannot do without the API.
+func (r *RepositoryResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// The plan is null when the repository is destroyed, and the client is not configured during validation.
+	if request.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var format, location types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("format"), &format)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("location"), &location)...)
+	var configuredLocation types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("location"), &configuredLocation)...)
+	var virtualConfig *VirtualRepositoryConfigModel
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("virtual_repository_config"), &virtualConfig)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	// An omitted location defaults to the location of the provider, which is known when planning a new repository.
+	if configuredLocation.IsNull() && (location.IsUnknown() || location.IsNull()) {
+		location = types.StringValue(r.client.Location)
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("location"), location)...)
+	}
+	if response.Diagnostics.HasError() || virtualConfig == nil || format.IsUnknown() || location.IsUnknown() {
+		return
+	}
+
+	for i, policy := range virtualConfig.UpstreamPolicies {
+		repositoryPath := path.Root("virtual_repository_config").AtName("upstream_policies").AtListIndex(i).AtName("repository")
+		// Upstreams created in the same apply are only known then.
+		if policy.Repository.IsUnknown() || !repositoryNameRegex.MatchString(policy.Repository.ValueString()) {
+			continue
+		}
+		upstream, err := r.client.GetRepositoryByName(ctx, policy.Repository.ValueString())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(repositoryPath, "failed to read upstream repository", err.Error())
+			continue
+		}
+		response.Diagnostics.Append(validateVirtualRepositoryUpstream(repositoryPath, format.ValueString(), location.ValueString(), upstream)...)
+	}
+}
+
+func (r *RepositoryResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data RepositoryResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if data.Location.IsUnknown() || data.Location.IsNull() {
+		data.Location = types.StringValue(r.client.Location)
+	}
+
+	repository, diags := repositoryFromModel(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	repository.Format = data.Format.ValueString()
+	repository.Mode = data.Mode.ValueString()
+	repository.KmsKeyName = data.KmsKeyName.ValueString()
+
+	operation, err := r.client.CreateRepository(ctx, data.Location.ValueString(), data.RepositoryID.ValueString(), repository)
+	if err == nil {
+		_, err = r.client.WaitForOperation(ctx, operation)
+	}
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to create repository", err.Error()))
+		return
+	}
+
+	created, err := r.client.GetRepository(ctx, data.Location.ValueString(), data.RepositoryID.ValueString())
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to read created repository", err.Error()))
+		return
+	}
+	response.Diagnostics.Append(repositoryToModel(ctx, created, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data RepositoryResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	repository, err := r.client.GetRepository(ctx, data.Location.ValueString(), data.RepositoryID.ValueString())
+	if artifactregistrydockerimagesclient.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to read repository", err.Error()))
+		return
+	}
+	response.Diagnostics.Append(repositoryToModel(ctx, repository, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data RepositoryResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	repository, diags := repositoryFromModel(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	repository.Name = data.ID.ValueString()
+	updateMask := append([]string{}, repositoryUpdateMask...)
+	if data.RemoteRepositoryConfig != nil {
+		updateMask = append(updateMask, remoteRepositoryUpdateMask...)
+	}
+	if data.VirtualRepositoryConfig != nil {
+		updateMask = append(updateMask, "virtualRepositoryConfig")
+	}
+
+	updated, err := r.client.UpdateRepository(ctx, repository, updateMask)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to update repository", err.Error()))
+		return
+	}
+	response.Diagnostics.Append(repositoryToModel(ctx, updated, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data RepositoryResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	operation, err := r.client.DeleteRepository(ctx, data.ID.ValueString())
+	if err == nil {
+		_, err = r.client.WaitForOperation(ctx, operation)
+	}
+	if err != nil && !artifactregistrydockerimagesclient.IsNotFound(err) {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to delete repository", err.Error()))
+	}
+}
+
+// ImportState imports a repository by its resource name, e.g. projects/devops-339608/locations/europe/repositories/services.
+func (r *RepositoryResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	segments := strings.Split(request.ID, "/")
+	if len(segments) != 6 || segments[0] != "projects" || segments[2] != "locations" || segments[4] != "repositories" {
+		response.Diagnostics.AddError("invalid import ID",
+			fmt.Sprintf("Expected projects/<project>/locations/<location>/repositories/<repository_id>, got %q.", request.ID))
+		return
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("location"), segments[3])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("repository_id"), segments[5])...)
+}
+
+// repositoryFromModel converts the fields of the model that can be updated in place.
+func repositoryFromModel(ctx context.Context, data *RepositoryResourceModel) (*artifactregistrydockerimagesclient.Repository, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	repository := &artifactregistrydockerimagesclient.Repository{
+		Description:             data.Description.ValueString(),
+		CleanupPolicyDryRun:     data.CleanupPolicyDryRun.ValueBool(),
+		RemoteRepositoryConfig:  remoteRepositoryConfigToAPI(data.Format.ValueString(), data.RemoteRepositoryConfig),
+		VirtualRepositoryConfig: virtualRepositoryConfigToAPI(data.VirtualRepositoryConfig),
+	}
+	diags.Append(data.Labels.ElementsAs(ctx, &repository.Labels, false)...)
+	cleanupPolicies, policyDiags := cleanupPoliciesToAPI(ctx, data.CleanupPolicies)
+	diags.Append(policyDiags...)
+	repository.CleanupPolicies = cleanupPolicies
+	return repository, diags
+}
+
+// repositoryToModel copies the repository into data, keeping the nulls of unset optional attributes.
+func repositoryToModel(ctx context.Context, repository *artifactregistrydockerimagesclient.Repository, data *RepositoryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	data.ID = types.StringValue(repository.Name)
+	data.RepositoryID = types.StringValue(repository.ID())
+	data.Format = types.StringValue(repository.Format)
+	data.Mode = types.StringValue(repository.Mode)
+	// The API omits an empty description, keep a configured "" instead of turning it into null.
+	if repository.Description != "" || data.Description.IsUnknown() || data.Description.ValueString() != "" {
+		data.Description = stringValueOrNull(repository.Description)
+	}
+	data.KmsKeyName = stringValueOrNull(repository.KmsKeyName)
+	data.CleanupPolicyDryRun = types.BoolValue(repository.CleanupPolicyDryRun)
+	data.CleanupPolicies = cleanupPoliciesFromAPI(repository.CleanupPolicies)
+	data.RemoteRepositoryConfig = remoteRepositoryConfigFromAPI(repository.Format, repository.RemoteRepositoryConfig)
+	data.VirtualRepositoryConfig = virtualRepositoryConfigFromAPI(repository.VirtualRepositoryConfig, data.VirtualRepositoryConfig)
+	data.CreateTime = types.StringValue(repository.CreateTime)
+	data.UpdateTime = types.StringValue(repository.UpdateTime)
+	if len(repository.Labels) == 0 {
+		// The API omits empty labels too, keep a configured {} instead of turning it into null.
+		if data.Labels.IsUnknown() || data.Labels.IsNull() || len(data.Labels.Elements()) > 0 {
+			data.Labels = types.MapNull(types.StringType)
+		}
+	} else {
+		labels, labelDiags := types.MapValueFrom(ctx, types.StringType, repository.Labels)
+		diags.Append(labelDiags...)
+		data.Labels = labels
+	}
+	return diags
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
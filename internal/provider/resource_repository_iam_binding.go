@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RepositoryIamBindingResource{}
+var _ resource.ResourceWithConfigure = &RepositoryIamBindingResource{}
+var _ resource.ResourceWithValidateConfig = &RepositoryIamBindingResource{}
+var _ resource.ResourceWithImportState = &RepositoryIamBindingResource{}
+
+func NewRepositoryIamBindingResource() resource.Resource {
+	return &RepositoryIamBindingResource{}
+}
+
+// RepositoryIamBindingResource authoritatively manages the members of a role in the IAM policy of a repository.
+type RepositoryIamBindingResource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// RepositoryIamBindingResourceModel defines the resource model.
+type RepositoryIamBindingResourceModel struct {
+	ID         types.String       `tfsdk:"id"`
+	Repository types.String       `tfsdk:"repository"`
+	Location   types.String       `tfsdk:"location"`
+	Etag       types.String       `tfsdk:"etag"`
+	Role       types.String       `tfsdk:"role"`
+	Members    []string           `tfsdk:"members"`
+	Condition  *IamConditionModel `tfsdk:"condition"`
+}
+
+func (r *RepositoryIamBindingResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_repository_iam_binding"
+}
+
+func (r *RepositoryIamBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RepositoryIamBindingResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	attributes := repositoryIamAttributes()
+	attributes["role"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The role to grant, e.g. roles/artifactregistry.reader.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["members"] = schema.SetAttribute{
+		Required:    true,
+		ElementType: types.StringType,
+		Description: "The members granted the role, e.g. serviceAccount:campaign-service@devops-339608.iam.gserviceaccount.com.",
+	}
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This resource authoritatively manages the members of a role in the IAM policy of a repository. " +
+			"Other roles of the policy, and bindings of the same role with another condition, are left untouched.",
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"condition": repositoryIamConditionBlock(),
+		},
+	}
+}
+
+func (r *RepositoryIamBindingResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var condition *IamConditionModel
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("condition"), &condition)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(validateIamCondition(path.Root("condition"), condition)...)
+}
+
+func (r *RepositoryIamBindingResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data RepositoryIamBindingResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	repositoryName := repositoryIamDefaults(r.client, &data.Location, &data.Repository)
+	data.ID = types.StringValue(repositoryIamID(repositoryName, iamConditionFromModel(data.Condition), data.Role.ValueString()))
+
+	response.Diagnostics.Append(r.setMembers(ctx, &data, repositoryName, data.Members)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryIamBindingResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data RepositoryIamBindingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	repositoryName := r.client.RepositoryName(data.Location.ValueString(), data.Repository.ValueString())
+	policy, err := r.client.GetIamPolicy(ctx, repositoryName)
+	if artifactregistrydockerimagesclient.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to read IAM policy", err.Error()))
+		return
+	}
+	binding := findBinding(policy, data.Role.ValueString(), iamConditionFromModel(data.Condition))
+	if binding == nil || len(binding.Members) == 0 {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	data.Members = binding.Members
+	data.Condition = iamConditionToModel(binding.Condition)
+	data.Etag = types.StringValue(policy.Etag)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryIamBindingResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data RepositoryIamBindingResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	repositoryName := r.client.RepositoryName(data.Location.ValueString(), data.Repository.ValueString())
+	response.Diagnostics.Append(r.setMembers(ctx, &data, repositoryName, data.Members)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryIamBindingResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data RepositoryIamBindingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	repositoryName := r.client.RepositoryName(data.Location.ValueString(), data.Repository.ValueString())
+	response.Diagnostics.Append(r.setMembers(ctx, &data, repositoryName, nil)...)
+}
+
+// ImportState imports a binding by the resource name of the repository and the role separated by a space, e.g.
+// "projects/devops-339608/locations/europe/repositories/services roles/artifactregistry.reader". The binding of a
+// condition is imported by appending the title of the condition.
+func (r *RepositoryIamBindingResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	location, repository, rest, err := parseRepositoryIamID(request.ID, 1, true)
+	if err != nil {
+		response.Diagnostics.AddError("invalid import ID", err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("location"), location)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("repository"), repository)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("role"), rest[0])...)
+	if len(rest) > 1 {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("condition").AtName("title"), rest[1])...)
+	}
+}
+
+// setMembers replaces the members of the role and condition in the IAM policy of the repository.
+func (r *RepositoryIamBindingResource) setMembers(ctx context.Context, data *RepositoryIamBindingResourceModel, repositoryName string, members []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	policy, err := modifyRepositoryIamPolicy(ctx, r.client, repositoryName, func(policy *artifactregistrydockerimagesclient.Policy) error {
+		setBindingMembers(policy, data.Role.ValueString(), iamConditionFromModel(data.Condition), members)
+		return nil
+	})
+	if artifactregistrydockerimagesclient.IsNotFound(err) && members == nil {
+		return diags
+	}
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("failed to set IAM binding", err.Error()))
+		return diags
+	}
+	data.Etag = types.StringValue(policy.Etag)
+	return diags
+}
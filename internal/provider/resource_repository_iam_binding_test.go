@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRepositoryIamBindingResource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+resource "artifactregistry_repository" "test" {
+	repository_id = "terraform-provider-iam-acceptance-test"
+	format = "DOCKER"
+}
+resource "artifactregistry_repository_iam_binding" "test" {
+	repository = artifactregistry_repository.test.repository_id
+	role = "roles/artifactregistry.reader"
+	members = ["allAuthenticatedUsers"]
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("artifactregistry_repository_iam_binding.test", "id", "projects/devops-339608/locations/europe/repositories/terraform-provider-iam-acceptance-test roles/artifactregistry.reader"),
+					resource.TestCheckResourceAttr("artifactregistry_repository_iam_binding.test", "members.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "artifactregistry_repository_iam_binding.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RepositoryIamMemberResource{}
+var _ resource.ResourceWithConfigure = &RepositoryIamMemberResource{}
+var _ resource.ResourceWithValidateConfig = &RepositoryIamMemberResource{}
+var _ resource.ResourceWithImportState = &RepositoryIamMemberResource{}
+
+func NewRepositoryIamMemberResource() resource.Resource {
+	return &RepositoryIamMemberResource{}
+}
+
+// RepositoryIamMemberResource grants a role to a single member in the IAM policy of a repository.
+type RepositoryIamMemberResource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// RepositoryIamMemberResourceModel defines the resource model.
+type RepositoryIamMemberResourceModel struct {
+	ID         types.String       `tfsdk:"id"`
+	Repository types.String       `tfsdk:"repository"`
+	Location   types.String       `tfsdk:"location"`
+	Etag       types.String       `tfsdk:"etag"`
+	Role       types.String       `tfsdk:"role"`
+	Member     types.String       `tfsdk:"member"`
+	Condition  *IamConditionModel `tfsdk:"condition"`
+}
+
+func (r *RepositoryIamMemberResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_repository_iam_member"
+}
+
+func (r *RepositoryIamMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RepositoryIamMemberResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	attributes := repositoryIamAttributes()
+	attributes["role"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The role to grant, e.g. roles/artifactregistry.reader.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["member"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The member granted the role, e.g. serviceAccount:campaign-service@devops-339608.iam.gserviceaccount.com.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This resource grants a role to a single member in the IAM policy of a repository. " +
+			"Other members of the role, and members of the role under another condition, are left untouched.",
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"condition": repositoryIamConditionBlock(),
+		},
+	}
+}
+
+func (r *RepositoryIamMemberResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var condition *IamConditionModel
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("condition"), &condition)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(validateIamCondition(path.Root("condition"), condition)...)
+}
+
+func (r *RepositoryIamMemberResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data RepositoryIamMemberResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	repositoryName := repositoryIamDefaults(r.client, &data.Location, &data.Repository)
+	data.ID = types.StringValue(repositoryIamID(repositoryName, iamConditionFromModel(data.Condition), data.Role.ValueString(), data.Member.ValueString()))
+
+	policy, err := modifyRepositoryIamPolicy(ctx, r.client, repositoryName, func(policy *artifactregistrydockerimagesclient.Policy) error {
+		addBindingMember(policy, data.Role.ValueString(), iamConditionFromModel(data.Condition), data.Member.ValueString())
+		return nil
+	})
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to add IAM member", err.Error()))
+		return
+	}
+	data.Etag = types.StringValue(policy.Etag)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryIamMemberResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data RepositoryIamMemberResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	repositoryName := r.client.RepositoryName(data.Location.ValueString(), data.Repository.ValueString())
+	policy, err := r.client.GetIamPolicy(ctx, repositoryName)
+	if artifactregistrydockerimagesclient.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to read IAM policy", err.Error()))
+		return
+	}
+	binding := findBinding(policy, data.Role.ValueString(), iamConditionFromModel(data.Condition))
+	if binding == nil || !contains(binding.Members, data.Member.ValueString()) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	data.Condition = iamConditionToModel(binding.Condition)
+	data.Etag = types.StringValue(policy.Etag)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// Update is never called, every attribute requires a replacement.
+func (r *RepositoryIamMemberResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data RepositoryIamMemberResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryIamMemberResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data RepositoryIamMemberResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	repositoryName := r.client.RepositoryName(data.Location.ValueString(), data.Repository.ValueString())
+	_, err := modifyRepositoryIamPolicy(ctx, r.client, repositoryName, func(policy *artifactregistrydockerimagesclient.Policy) error {
+		removeBindingMember(policy, data.Role.ValueString(), iamConditionFromModel(data.Condition), data.Member.ValueString())
+		return nil
+	})
+	if err != nil && !artifactregistrydockerimagesclient.IsNotFound(err) {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to remove IAM member", err.Error()))
+	}
+}
+
+// ImportState imports a member by the resource name of the repository, the role and the member separated by spaces,
+// e.g. "projects/devops-339608/locations/europe/repositories/services roles/artifactregistry.reader user:jane@example.com".
+// The member of a condition is imported by appending the title of the condition.
+func (r *RepositoryIamMemberResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	location, repository, rest, err := parseRepositoryIamID(request.ID, 2, true)
+	if err != nil {
+		response.Diagnostics.AddError("invalid import ID", err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("location"), location)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("repository"), repository)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("role"), rest[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("member"), rest[1])...)
+	if len(rest) > 2 {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("condition").AtName("title"), rest[2])...)
+	}
+}
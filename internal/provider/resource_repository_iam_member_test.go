@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRepositoryIamMemberResource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+resource "artifactregistry_repository" "test" {
+	repository_id = "terraform-provider-iam-acceptance-test"
+	format = "DOCKER"
+}
+resource "artifactregistry_repository_iam_member" "test" {
+	repository = artifactregistry_repository.test.repository_id
+	role = "roles/artifactregistry.reader"
+	member = "allAuthenticatedUsers"
+}
+resource "artifactregistry_repository_iam_member" "conditional" {
+	repository = artifactregistry_repository.test.repository_id
+	role = "roles/artifactregistry.reader"
+	member = "allAuthenticatedUsers"
+	condition {
+		title = "release tags"
+		expression = "resource.name.extract(\"tags/{tag}\").startsWith(\"release-\")"
+	}
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("artifactregistry_repository_iam_member.test", "id", "projects/devops-339608/locations/europe/repositories/terraform-provider-iam-acceptance-test roles/artifactregistry.reader allAuthenticatedUsers"),
+					resource.TestCheckResourceAttrSet("artifactregistry_repository_iam_member.test", "etag"),
+					resource.TestCheckResourceAttr("artifactregistry_repository_iam_member.conditional", "id", "projects/devops-339608/locations/europe/repositories/terraform-provider-iam-acceptance-test roles/artifactregistry.reader allAuthenticatedUsers release tags"),
+				),
+			},
+			{
+				ResourceName:      "artifactregistry_repository_iam_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "artifactregistry_repository_iam_member.conditional",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
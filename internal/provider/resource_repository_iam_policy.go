@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"reflect"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RepositoryIamPolicyResource{}
+var _ resource.ResourceWithConfigure = &RepositoryIamPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &RepositoryIamPolicyResource{}
+var _ resource.ResourceWithImportState = &RepositoryIamPolicyResource{}
+
+func NewRepositoryIamPolicyResource() resource.Resource {
+	return &RepositoryIamPolicyResource{}
+}
+
+// RepositoryIamPolicyResource authoritatively manages the whole IAM policy of a repository.
+type RepositoryIamPolicyResource struct {
+	client *artifactregistrydockerimagesclient.Client
+}
+
+// RepositoryIamPolicyResourceModel defines the resource model.
+type RepositoryIamPolicyResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Repository types.String `tfsdk:"repository"`
+	Location   types.String `tfsdk:"location"`
+	Etag       types.String `tfsdk:"etag"`
+	PolicyData types.String `tfsdk:"policy_data"`
+}
+
+// iamPolicyData is the JSON document of the policy_data attribute.
+type iamPolicyData struct {
+	Bindings []artifactregistrydockerimagesclient.Binding `json:"bindings"`
+}
+
+func (r *RepositoryIamPolicyResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_repository_iam_policy"
+}
+
+func (r *RepositoryIamPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*artifactregistrydockerimagesclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *artifactregistrydockerimagesclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RepositoryIamPolicyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	attributes := repositoryIamAttributes()
+	attributes["policy_data"] = schema.StringAttribute{
+		Required: true,
+		Description: "The IAM policy as JSON, e.g. jsonencode({ bindings = [{ role = \"roles/artifactregistry.reader\", members = [\"serviceAccount:...\"] }] }). " +
+			"Bindings may have a condition with a title, description and expression. Bindings not in the policy are removed from the repository.",
+	}
+	response.Schema = schema.Schema{
+		MarkdownDescription: "This resource authoritatively manages the IAM policy of a repository. " +
+			"It cannot be used together with artifactregistry_repository_iam_binding or artifactregistry_repository_iam_member on the same repository.",
+		Attributes: attributes,
+	}
+}
+
+func (r *RepositoryIamPolicyResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var policyData types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("policy_data"), &policyData)...)
+	if response.Diagnostics.HasError() || policyData.IsNull() || policyData.IsUnknown() {
+		return
+	}
+	if _, err := parseIamPolicyData(policyData.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("policy_data"), "invalid policy_data", err.Error())
+	}
+}
+
+func (r *RepositoryIamPolicyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data RepositoryIamPolicyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	repositoryName := repositoryIamDefaults(r.client, &data.Location, &data.Repository)
+	data.ID = types.StringValue(repositoryName)
+
+	response.Diagnostics.Append(r.setPolicy(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryIamPolicyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data RepositoryIamPolicyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetIamPolicy(ctx, data.ID.ValueString())
+	if artifactregistrydockerimagesclient.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to read IAM policy", err.Error()))
+		return
+	}
+	data.Etag = types.StringValue(policy.Etag)
+
+	// Keep the configured JSON when it describes the same bindings, so that formatting and ordering don't show as drift.
+	bindings := normalizeBindings(policy.Bindings)
+	current, err := parseIamPolicyData(data.PolicyData.ValueString())
+	if err != nil || !reflect.DeepEqual(normalizeBindings(current.Bindings), bindings) {
+		policyData, err := json.Marshal(iamPolicyData{Bindings: bindings})
+		if err != nil {
+			response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to encode IAM policy", err.Error()))
+			return
+		}
+		data.PolicyData = types.StringValue(string(policyData))
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryIamPolicyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data RepositoryIamPolicyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.setPolicy(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// Delete removes all bindings from the IAM policy of the repository.
+func (r *RepositoryIamPolicyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data RepositoryIamPolicyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := modifyRepositoryIamPolicy(ctx, r.client, data.ID.ValueString(), func(policy *artifactregistrydockerimagesclient.Policy) error {
+		policy.Bindings = nil
+		return nil
+	})
+	if err != nil && !artifactregistrydockerimagesclient.IsNotFound(err) {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("failed to delete IAM policy", err.Error()))
+	}
+}
+
+// ImportState imports the IAM policy by the resource name of the repository, e.g.
+// projects/devops-339608/locations/europe/repositories/services.
+func (r *RepositoryIamPolicyResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	location, repository, _, err := parseRepositoryIamID(request.ID, 0, false)
+	if err != nil {
+		response.Diagnostics.AddError("invalid import ID", err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("location"), location)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("repository"), repository)...)
+}
+
+// setPolicy replaces the bindings of the IAM policy of the repository with the bindings of policy_data.
+func (r *RepositoryIamPolicyResource) setPolicy(ctx context.Context, data *RepositoryIamPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	policyData, err := parseIamPolicyData(data.PolicyData.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("policy_data"), "invalid policy_data", err.Error())
+		return diags
+	}
+	policy, err := modifyRepositoryIamPolicy(ctx, r.client, data.ID.ValueString(), func(policy *artifactregistrydockerimagesclient.Policy) error {
+		policy.Bindings = policyData.Bindings
+		return nil
+	})
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("failed to set IAM policy", err.Error()))
+		return diags
+	}
+	data.Etag = types.StringValue(policy.Etag)
+	return diags
+}
+
+func parseIamPolicyData(policyData string) (*iamPolicyData, error) {
+	var data iamPolicyData
+	if err := json.Unmarshal([]byte(policyData), &data); err != nil {
+		return nil, err
+	}
+	for i, binding := range data.Bindings {
+		if binding.Role == "" {
+			return nil, fmt.Errorf("binding %d has no role", i)
+		}
+		if len(binding.Members) == 0 {
+			return nil, fmt.Errorf("binding %d of role %s has no members", i, binding.Role)
+		}
+		if binding.Condition != nil && (binding.Condition.Title == "" || binding.Condition.Expression == "") {
+			return nil, fmt.Errorf("the condition of binding %d of role %s requires a title and an expression", i, binding.Role)
+		}
+	}
+	return &data, nil
+}
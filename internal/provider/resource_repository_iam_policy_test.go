@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRepositoryIamPolicyResource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+resource "artifactregistry_repository" "test" {
+	repository_id = "terraform-provider-iam-acceptance-test"
+	format = "DOCKER"
+}
+resource "artifactregistry_repository_iam_policy" "test" {
+	repository = artifactregistry_repository.test.repository_id
+	policy_data = jsonencode({
+		bindings = [{
+			role = "roles/artifactregistry.reader"
+			members = ["allAuthenticatedUsers"]
+		}]
+	})
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("artifactregistry_repository_iam_policy.test", "id", "projects/devops-339608/locations/europe/repositories/terraform-provider-iam-acceptance-test"),
+					resource.TestCheckResourceAttr("artifactregistry_repository_iam_policy.test", "location", "europe"),
+					resource.TestCheckResourceAttrSet("artifactregistry_repository_iam_policy.test", "etag"),
+				),
+			},
+			{
+				ResourceName:            "artifactregistry_repository_iam_policy.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_data"},
+			},
+		},
+	})
+}
@@ -0,0 +1,575 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/imroc/req/v3"
+)
+
+func TestAccRepositoryResource(t *testing.T) {
+	config := func(keepCount string) string {
+		return `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+resource "artifactregistry_repository" "test" {
+	repository_id = "terraform-provider-acceptance-test"
+	format = "DOCKER"
+	description = "Created by the acceptance tests of terraform-provider-artifact-registry."
+	cleanup_policy_dry_run = true
+
+	cleanup_policies {
+		id = "delete-untagged"
+		action = "DELETE"
+		condition {
+			tag_state = "UNTAGGED"
+			older_than = "2592000s"
+		}
+	}
+	cleanup_policies {
+		id = "keep-most-recent"
+		action = "KEEP"
+		most_recent_versions {
+			keep_count = ` + keepCount + `
+		}
+	}
+}
+`
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("artifactregistry_repository.test", "id", "projects/devops-339608/locations/europe/repositories/terraform-provider-acceptance-test"),
+					resource.TestCheckResourceAttr("artifactregistry_repository.test", "location", "europe"),
+					resource.TestCheckResourceAttr("artifactregistry_repository.test", "mode", "STANDARD_REPOSITORY"),
+					resource.TestCheckResourceAttr("artifactregistry_repository.test", "cleanup_policies.#", "2"),
+				),
+			},
+			{
+				Config: config("10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("artifactregistry_repository.test", "cleanup_policies.*", map[string]string{
+						"id":                              "keep-most-recent",
+						"most_recent_versions.keep_count": "10",
+					}),
+				),
+			},
+			{
+				ResourceName:      "artifactregistry_repository.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRemoteRepositoryResource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+resource "artifactregistry_repository" "test" {
+	repository_id = "terraform-provider-remote-acceptance-test"
+	format = "DOCKER"
+	mode = "REMOTE_REPOSITORY"
+
+	remote_repository_config {
+		description = "Docker Hub"
+		upstream_preset = "DOCKER_HUB"
+	}
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("artifactregistry_repository.test", "mode", "REMOTE_REPOSITORY"),
+					resource.TestCheckResourceAttr("artifactregistry_repository.test", "remote_repository_config.upstream_preset", "DOCKER_HUB"),
+				),
+			},
+			{
+				ResourceName:      "artifactregistry_repository.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVirtualRepositoryResource(t *testing.T) {
+	config := `
+provider "artifactregistry" {
+	project = "devops-339608"
+	location = "europe"
+	repository = "services"
+}
+resource "artifactregistry_repository" "upstream" {
+	repository_id = "terraform-provider-upstream-acceptance-test"
+	format = "DOCKER"
+}
+resource "artifactregistry_repository" "test" {
+	repository_id = "terraform-provider-virtual-acceptance-test"
+	format = "DOCKER"
+	mode = "VIRTUAL_REPOSITORY"
+
+	virtual_repository_config {
+		upstream_policies {
+			id = "upstream"
+			repository = artifactregistry_repository.upstream.id
+			priority = 10
+		}
+	}
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("artifactregistry_repository.test", "mode", "VIRTUAL_REPOSITORY"),
+					resource.TestCheckResourceAttrPair("artifactregistry_repository.test", "virtual_repository_config.upstream_policies.0.repository",
+						"artifactregistry_repository.upstream", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateCleanupPolicies(t *testing.T) {
+	prefixes := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("release-")})
+	testCases := map[string]struct {
+		policy CleanupPolicyModel
+		valid  bool
+	}{
+		"delete older than": {
+			policy: CleanupPolicyModel{
+				ID:     types.StringValue("delete-old"),
+				Action: types.StringValue("DELETE"),
+				Condition: &CleanupPolicyConditionModel{
+					TagState:  types.StringValue("ANY"),
+					OlderThan: types.StringValue("2592000s"),
+				},
+			},
+			valid: true,
+		},
+		"keep most recent": {
+			policy: CleanupPolicyModel{
+				ID:                 types.StringValue("keep"),
+				Action:             types.StringValue("KEEP"),
+				MostRecentVersions: &CleanupPolicyMostRecentVersionsModel{KeepCount: types.Int64Value(5)},
+			},
+			valid: true,
+		},
+		"unknown action": {
+			policy: CleanupPolicyModel{
+				ID:        types.StringValue("archive"),
+				Action:    types.StringValue("ARCHIVE"),
+				Condition: &CleanupPolicyConditionModel{},
+			},
+		},
+		"condition and most recent versions": {
+			policy: CleanupPolicyModel{
+				ID:                 types.StringValue("both"),
+				Action:             types.StringValue("KEEP"),
+				Condition:          &CleanupPolicyConditionModel{},
+				MostRecentVersions: &CleanupPolicyMostRecentVersionsModel{KeepCount: types.Int64Value(5)},
+			},
+		},
+		"delete most recent versions": {
+			policy: CleanupPolicyModel{
+				ID:                 types.StringValue("delete-recent"),
+				Action:             types.StringValue("DELETE"),
+				MostRecentVersions: &CleanupPolicyMostRecentVersionsModel{KeepCount: types.Int64Value(5)},
+			},
+		},
+		"tag prefixes of untagged versions": {
+			policy: CleanupPolicyModel{
+				ID:     types.StringValue("untagged"),
+				Action: types.StringValue("DELETE"),
+				Condition: &CleanupPolicyConditionModel{
+					TagState:    types.StringValue("UNTAGGED"),
+					TagPrefixes: prefixes,
+				},
+			},
+		},
+		"duration in days": {
+			policy: CleanupPolicyModel{
+				ID:        types.StringValue("days"),
+				Action:    types.StringValue("DELETE"),
+				Condition: &CleanupPolicyConditionModel{OlderThan: types.StringValue("30d")},
+			},
+		},
+		"unknown values": {
+			policy: CleanupPolicyModel{
+				ID:        types.StringUnknown(),
+				Action:    types.StringUnknown(),
+				Condition: &CleanupPolicyConditionModel{TagState: types.StringUnknown(), OlderThan: types.StringUnknown()},
+			},
+			valid: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := validateCleanupPolicies(path.Root("cleanup_policies"), []CleanupPolicyModel{testCase.policy})
+			if diags.HasError() == testCase.valid {
+				t.Errorf("expected valid to be %t, got %v", testCase.valid, diags)
+			}
+		})
+	}
+
+	duplicate := CleanupPolicyModel{
+		ID:        types.StringValue("duplicate"),
+		Action:    types.StringValue("DELETE"),
+		Condition: &CleanupPolicyConditionModel{},
+	}
+	if diags := validateCleanupPolicies(path.Root("cleanup_policies"), []CleanupPolicyModel{duplicate, duplicate}); !diags.HasError() {
+		t.Error("expected duplicate ids to be invalid")
+	}
+}
+
+func TestValidateRemoteRepositoryConfig(t *testing.T) {
+	remote := types.StringValue("REMOTE_REPOSITORY")
+	testCases := map[string]struct {
+		format string
+		mode   types.String
+		config *RemoteRepositoryConfigModel
+		valid  bool
+	}{
+		"docker hub": {
+			format: "DOCKER",
+			mode:   remote,
+			config: &RemoteRepositoryConfigModel{UpstreamPreset: types.StringValue("DOCKER_HUB")},
+			valid:  true,
+		},
+		"custom maven upstream with credentials": {
+			format: "MAVEN",
+			mode:   remote,
+			config: &RemoteRepositoryConfigModel{
+				UpstreamURI: types.StringValue("https://repo.example.com/maven2"),
+				UpstreamCredentials: &UpstreamCredentialsModel{
+					Username:              types.StringValue("deploy"),
+					PasswordSecretVersion: types.StringValue("projects/devops-339608/secrets/maven/versions/latest"),
+				},
+			},
+			valid: true,
+		},
+		"preset of another format": {
+			format: "MAVEN",
+			mode:   remote,
+			config: &RemoteRepositoryConfigModel{UpstreamPreset: types.StringValue("DOCKER_HUB")},
+		},
+		"preset and uri": {
+			format: "NPM",
+			mode:   remote,
+			config: &RemoteRepositoryConfigModel{UpstreamPreset: types.StringValue("NPMJS"), UpstreamURI: types.StringValue("https://registry.npmjs.org")},
+		},
+		"http uri": {
+			format: "PYTHON",
+			mode:   remote,
+			config: &RemoteRepositoryConfigModel{UpstreamURI: types.StringValue("http://pypi.example.com")},
+		},
+		"unsupported format": {
+			format: "GENERIC",
+			mode:   remote,
+			config: &RemoteRepositoryConfigModel{UpstreamURI: types.StringValue("https://example.com")},
+		},
+		"secret instead of secret version": {
+			format: "DOCKER",
+			mode:   remote,
+			config: &RemoteRepositoryConfigModel{
+				UpstreamPreset: types.StringValue("DOCKER_HUB"),
+				UpstreamCredentials: &UpstreamCredentialsModel{
+					Username:              types.StringValue("deploy"),
+					PasswordSecretVersion: types.StringValue("projects/devops-339608/secrets/docker-hub"),
+				},
+			},
+		},
+		"config in standard mode": {
+			format: "DOCKER",
+			mode:   types.StringValue("STANDARD_REPOSITORY"),
+			config: &RemoteRepositoryConfigModel{UpstreamPreset: types.StringValue("DOCKER_HUB")},
+		},
+		"remote mode without config": {
+			format: "DOCKER",
+			mode:   remote,
+		},
+		"unknown mode": {
+			format: "DOCKER",
+			mode:   types.StringUnknown(),
+			config: &RemoteRepositoryConfigModel{UpstreamPreset: types.StringUnknown(), UpstreamURI: types.StringNull()},
+			valid:  true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := testCase.config
+			if config != nil {
+				if config.UpstreamPreset == (types.String{}) {
+					config.UpstreamPreset = types.StringNull()
+				}
+				if config.UpstreamURI == (types.String{}) {
+					config.UpstreamURI = types.StringNull()
+				}
+			}
+			diags := validateRemoteRepositoryConfig(path.Root("remote_repository_config"), types.StringValue(testCase.format), testCase.mode, config)
+			if diags.HasError() == testCase.valid {
+				t.Errorf("expected valid to be %t, got %v", testCase.valid, diags)
+			}
+		})
+	}
+}
+
+func TestValidateVirtualRepositoryConfig(t *testing.T) {
+	virtual := types.StringValue("VIRTUAL_REPOSITORY")
+	upstream := func(id string, repository string, priority int64) UpstreamPolicyModel {
+		return UpstreamPolicyModel{ID: types.StringValue(id), Repository: types.StringValue(repository), Priority: types.Int64Value(priority)}
+	}
+	testCases := map[string]struct {
+		mode   types.String
+		config *VirtualRepositoryConfigModel
+		valid  bool
+	}{
+		"internal and remote upstreams": {
+			mode: virtual,
+			config: &VirtualRepositoryConfigModel{UpstreamPolicies: []UpstreamPolicyModel{
+				upstream("internal", "projects/devops-339608/locations/europe/repositories/services", 20),
+				upstream("docker-hub", "projects/devops-339608/locations/europe/repositories/docker-hub", 10),
+			}},
+			valid: true,
+		},
+		"no upstreams": {
+			mode:   virtual,
+			config: &VirtualRepositoryConfigModel{},
+		},
+		"duplicate ids": {
+			mode: virtual,
+			config: &VirtualRepositoryConfigModel{UpstreamPolicies: []UpstreamPolicyModel{
+				upstream("internal", "projects/devops-339608/locations/europe/repositories/services", 20),
+				upstream("internal", "projects/devops-339608/locations/europe/repositories/docker-hub", 10),
+			}},
+		},
+		"duplicate repositories": {
+			mode: virtual,
+			config: &VirtualRepositoryConfigModel{UpstreamPolicies: []UpstreamPolicyModel{
+				upstream("internal", "projects/devops-339608/locations/europe/repositories/services", 20),
+				upstream("services", "projects/devops-339608/locations/europe/repositories/services", 10),
+			}},
+		},
+		"duplicate priorities": {
+			mode: virtual,
+			config: &VirtualRepositoryConfigModel{UpstreamPolicies: []UpstreamPolicyModel{
+				upstream("internal", "projects/devops-339608/locations/europe/repositories/services", 10),
+				upstream("docker-hub", "projects/devops-339608/locations/europe/repositories/docker-hub", 10),
+			}},
+		},
+		"zero priority": {
+			mode: virtual,
+			config: &VirtualRepositoryConfigModel{UpstreamPolicies: []UpstreamPolicyModel{
+				upstream("internal", "projects/devops-339608/locations/europe/repositories/services", 0),
+			}},
+		},
+		"repository id instead of resource name": {
+			mode: virtual,
+			config: &VirtualRepositoryConfigModel{UpstreamPolicies: []UpstreamPolicyModel{
+				upstream("internal", "services", 10),
+			}},
+		},
+		"config in standard mode": {
+			mode: types.StringValue("STANDARD_REPOSITORY"),
+			config: &VirtualRepositoryConfigModel{UpstreamPolicies: []UpstreamPolicyModel{
+				upstream("internal", "projects/devops-339608/locations/europe/repositories/services", 10),
+			}},
+		},
+		"virtual mode without config": {
+			mode: virtual,
+		},
+		"unknown repository": {
+			mode: virtual,
+			config: &VirtualRepositoryConfigModel{UpstreamPolicies: []UpstreamPolicyModel{
+				{ID: types.StringValue("internal"), Repository: types.StringUnknown(), Priority: types.Int64Value(10)},
+			}},
+			valid: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := validateVirtualRepositoryConfig(path.Root("virtual_repository_config"), testCase.mode, testCase.config)
+			if diags.HasError() == testCase.valid {
+				t.Errorf("expected valid to be %t, got %v", testCase.valid, diags)
+			}
+		})
+	}
+}
+
+func TestValidateVirtualRepositoryUpstream(t *testing.T) {
+	testCases := map[string]struct {
+		upstream artifactregistrydockerimagesclient.Repository
+		valid    bool
+	}{
+		"same format and location": {
+			upstream: artifactregistrydockerimagesclient.Repository{Name: "projects/devops-339608/locations/europe/repositories/services", Format: "DOCKER", Mode: "STANDARD_REPOSITORY"},
+			valid:    true,
+		},
+		"other location": {
+			upstream: artifactregistrydockerimagesclient.Repository{Name: "projects/devops-339608/locations/us/repositories/services", Format: "DOCKER", Mode: "STANDARD_REPOSITORY"},
+		},
+		"other format": {
+			upstream: artifactregistrydockerimagesclient.Repository{Name: "projects/devops-339608/locations/europe/repositories/maven", Format: "MAVEN", Mode: "REMOTE_REPOSITORY"},
+		},
+		"virtual upstream": {
+			upstream: artifactregistrydockerimagesclient.Repository{Name: "projects/devops-339608/locations/europe/repositories/all", Format: "DOCKER", Mode: "VIRTUAL_REPOSITORY"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := validateVirtualRepositoryUpstream(path.Root("repository"), "DOCKER", "europe", &testCase.upstream)
+			if diags.HasError() == testCase.valid {
+				t.Errorf("expected valid to be %t, got %v", testCase.valid, diags)
+			}
+		})
+	}
+}
+
+func TestVirtualRepositoryConfigFromAPIKeepsOrder(t *testing.T) {
+	previous := &VirtualRepositoryConfigModel{UpstreamPolicies: []UpstreamPolicyModel{
+		{ID: types.StringValue("docker-hub")},
+		{ID: types.StringValue("internal")},
+	}}
+	apiConfig := &artifactregistrydockerimagesclient.VirtualRepositoryConfig{UpstreamPolicies: []artifactregistrydockerimagesclient.UpstreamPolicy{
+		{ID: "cache", Repository: "projects/devops-339608/locations/europe/repositories/cache", Priority: 5},
+		{ID: "internal", Repository: "projects/devops-339608/locations/europe/repositories/services", Priority: 20},
+		{ID: "mirror", Repository: "projects/devops-339608/locations/europe/repositories/mirror", Priority: 15},
+		{ID: "docker-hub", Repository: "projects/devops-339608/locations/europe/repositories/docker-hub", Priority: 10},
+	}}
+	config := virtualRepositoryConfigFromAPI(apiConfig, previous)
+	var ids []string
+	for _, policy := range config.UpstreamPolicies {
+		ids = append(ids, policy.ID.ValueString())
+	}
+	if strings.Join(ids, ",") != "docker-hub,internal,mirror,cache" {
+		t.Errorf("unexpected order %v", ids)
+	}
+}
+
+func TestRepositoryModifyPlanDefaultsLocation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name": "projects/devops-339608/locations/us/repositories/services", "format": "DOCKER", "mode": "STANDARD_REPOSITORY"}`))
+	}))
+	defer server.Close()
+	repositoryResource := &RepositoryResource{client: &artifactregistrydockerimagesclient.Client{
+		Client:    req.C().SetBaseURL(server.URL + "/v1/"),
+		ProjectID: "devops-339608",
+		Location:  "europe",
+	}}
+	ctx := context.Background()
+	var schemaResponse frameworkresource.SchemaResponse
+	repositoryResource.Schema(ctx, frameworkresource.SchemaRequest{}, &schemaResponse)
+
+	data := RepositoryResourceModel{
+		ID:                  types.StringUnknown(),
+		RepositoryID:        types.StringValue("all"),
+		Location:            types.StringNull(),
+		Format:              types.StringValue("DOCKER"),
+		Mode:                types.StringValue("VIRTUAL_REPOSITORY"),
+		Description:         types.StringNull(),
+		Labels:              types.MapNull(types.StringType),
+		KmsKeyName:          types.StringNull(),
+		CleanupPolicyDryRun: types.BoolNull(),
+		VirtualRepositoryConfig: &VirtualRepositoryConfigModel{UpstreamPolicies: []UpstreamPolicyModel{{
+			ID:         types.StringValue("services"),
+			Repository: types.StringValue("projects/devops-339608/locations/us/repositories/services"),
+			Priority:   types.Int64Value(10),
+		}}},
+		CreateTime: types.StringUnknown(),
+		UpdateTime: types.StringUnknown(),
+	}
+	// tfsdk.Config cannot be set from a model, so its raw value is built as a state.
+	configState := tfsdk.State{Schema: schemaResponse.Schema}
+	diags := configState.Set(ctx, &data)
+	config := tfsdk.Config{Schema: schemaResponse.Schema, Raw: configState.Raw}
+	// Omitted Optional+Computed attributes are unknown in the plan of a new repository.
+	data.Location = types.StringUnknown()
+	plan := tfsdk.Plan{Schema: schemaResponse.Schema}
+	diags.Append(plan.Set(ctx, &data)...)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	request := frameworkresource.ModifyPlanRequest{
+		Config: config,
+		Plan:   plan,
+		State:  tfsdk.State{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil)},
+	}
+	response := frameworkresource.ModifyPlanResponse{Plan: plan}
+	repositoryResource.ModifyPlan(ctx, request, &response)
+
+	var location types.String
+	response.Plan.GetAttribute(ctx, path.Root("location"), &location)
+	if location.ValueString() != "europe" {
+		t.Errorf("expected the location of the provider to be planned, got %s", location)
+	}
+	if len(response.Diagnostics) != 1 || response.Diagnostics[0].Summary() != "invalid upstream location" {
+		t.Errorf("expected the upstream in another location to be rejected, got %v", response.Diagnostics)
+	}
+}
+
+func TestRepositoryToModelKeepsEmptyValues(t *testing.T) {
+	ctx := context.Background()
+	repository := &artifactregistrydockerimagesclient.Repository{Name: "projects/devops-339608/locations/europe/repositories/services", Format: "DOCKER"}
+
+	data := RepositoryResourceModel{Description: types.StringValue("")}
+	repositoryToModel(ctx, repository, &data)
+	if data.Description.IsNull() || data.Description.ValueString() != "" {
+		t.Errorf("expected the configured empty description to be kept, got %s", data.Description)
+	}
+
+	data = RepositoryResourceModel{Description: types.StringNull()}
+	repositoryToModel(ctx, repository, &data)
+	if !data.Description.IsNull() {
+		t.Errorf("expected an omitted description to stay null, got %s", data.Description)
+	}
+
+	data = RepositoryResourceModel{Labels: types.MapValueMust(types.StringType, map[string]attr.Value{})}
+	repositoryToModel(ctx, repository, &data)
+	if data.Labels.IsNull() || len(data.Labels.Elements()) != 0 {
+		t.Errorf("expected the configured empty labels to be kept, got %s", data.Labels)
+	}
+
+	data = RepositoryResourceModel{Labels: types.MapNull(types.StringType)}
+	repositoryToModel(ctx, repository, &data)
+	if !data.Labels.IsNull() {
+		t.Errorf("expected omitted labels to stay null, got %s", data.Labels)
+	}
+
+	data = RepositoryResourceModel{Description: types.StringValue("")}
+	repository.Description = "Docker images of the services"
+	repositoryToModel(ctx, repository, &data)
+	if data.Description.ValueString() != repository.Description {
+		t.Errorf("expected the description of the API, got %s", data.Description)
+	}
+}
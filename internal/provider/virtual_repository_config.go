@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"fmt"
+	artifactregistrydockerimagesclient "github.com/Fourth-Floor-Creative/terraform-provider-artifact-registry/artifact-registry-docker-images-client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"sort"
+)
+
+// repositoryNameRegex matches the resource name of a repository, capturing its project, location and repository ID.
+var repositoryNameRegex = regexp.MustCompile(`^projects/([^/]+)/locations/([^/]+)/repositories/([^/]+)$`)
+
+// VirtualRepositoryConfigModel lists the upstreams of a virtual repository. The list keeps the order of the
+// configuration, the priority decides which upstream is searched first.
+type VirtualRepositoryConfigModel struct {
+	UpstreamPolicies []UpstreamPolicyModel `tfsdk:"upstream_policies"`
+}
+
+type UpstreamPolicyModel struct {
+	ID         types.String `tfsdk:"id"`
+	Repository types.String `tfsdk:"repository"`
+	Priority   types.Int64  `tfsdk:"priority"`
+}
+
+// validateVirtualRepositoryConfig checks the config against the mode of the repository and for duplicate or malformed
+// upstreams. The format and location of the upstreams are checked against the API when planning. Unknown values are
+// skipped, they are validated once known.
+func validateVirtualRepositoryConfig(attributePath path.Path, mode types.String, config *VirtualRepositoryConfigModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !mode.IsUnknown() {
+		virtual := mode.ValueString() == repositoryModeVirtual
+		if virtual && config == nil {
+			diags.AddAttributeError(attributePath, "missing virtual_repository_config",
+				fmt.Sprintf("A repository in %s mode requires a virtual_repository_config.", repositoryModeVirtual))
+		}
+		if !virtual && config != nil {
+			diags.AddAttributeError(attributePath, "unexpected virtual_repository_config",
+				fmt.Sprintf("virtual_repository_config can only be set in %s mode.", repositoryModeVirtual))
+		}
+	}
+	if config == nil {
+		return diags
+	}
+
+	policiesPath := attributePath.AtName("upstream_policies")
+	if len(config.UpstreamPolicies) == 0 {
+		diags.AddAttributeError(policiesPath, "missing upstream_policies", "virtual_repository_config requires at least one upstream policy.")
+	}
+	ids := make(map[string]bool, len(config.UpstreamPolicies))
+	repositories := make(map[string]bool, len(config.UpstreamPolicies))
+	priorities := make(map[int64]bool, len(config.UpstreamPolicies))
+	for i, policy := range config.UpstreamPolicies {
+		policyPath := policiesPath.AtListIndex(i)
+		id := policy.ID.ValueString()
+		if !policy.ID.IsUnknown() {
+			if ids[id] {
+				diags.AddAttributeError(policyPath.AtName("id"), "duplicate upstream policy id", fmt.Sprintf("The id %q is used by more than one upstream policy.", id))
+			}
+			ids[id] = true
+		}
+
+		repository := policy.Repository.ValueString()
+		if !policy.Repository.IsUnknown() {
+			if !repositoryNameRegex.MatchString(repository) {
+				diags.AddAttributeError(policyPath.AtName("repository"), "invalid upstream repository",
+					fmt.Sprintf("The repository of upstream policy %q must be a resource name, e.g. projects/<project>/locations/<location>/repositories/<repository_id>, got %q.", id, repository))
+			} else if repositories[repository] {
+				diags.AddAttributeError(policyPath.AtName("repository"), "duplicate upstream repository",
+					fmt.Sprintf("The repository %q is referenced by more than one upstream policy.", repository))
+			}
+			repositories[repository] = true
+		}
+
+		if !policy.Priority.IsUnknown() {
+			priority := policy.Priority.ValueInt64()
+			if priority < 1 {
+				diags.AddAttributeError(policyPath.AtName("priority"), "invalid upstream priority",
+					fmt.Sprintf("The priority of upstream policy %q must be at least 1, got %d.", id, priority))
+			} else if priorities[priority] {
+				diags.AddAttributeError(policyPath.AtName("priority"), "duplicate upstream priority",
+					fmt.Sprintf("The priority %d is used by more than one upstream policy, the order of the upstreams would be ambiguous.", priority))
+			}
+			priorities[priority] = true
+		}
+	}
+	return diags
+}
+
+// validateVirtualRepositoryUpstream checks that an upstream has the format and location of the virtual repository.
+func validateVirtualRepositoryUpstream(attributePath path.Path, format string, location string, upstream *artifactregistrydockerimagesclient.Repository) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if upstreamLocation := repositoryNameLocation(upstream.Name); upstreamLocation != location {
+		diags.AddAttributeError(attributePath, "invalid upstream location",
+			fmt.Sprintf("The upstream %s must be in the location of the virtual repository, %s, got %s.", upstream.Name, location, upstreamLocation))
+	}
+	if upstream.Format != format {
+		diags.AddAttributeError(attributePath, "invalid upstream format",
+			fmt.Sprintf("The upstream %s must have the format of the virtual repository, %s, got %s.", upstream.Name, format, upstream.Format))
+	}
+	if upstream.Mode == repositoryModeVirtual {
+		diags.AddAttributeError(attributePath, "invalid upstream mode",
+			fmt.Sprintf("The upstream %s is a virtual repository itself, only %s and %s repositories can be upstreams.",
+				upstream.Name, repositoryModeStandard, repositoryModeRemote))
+	}
+	return diags
+}
+
+// repositoryNameLocation returns the location of a repository resource name, or an empty string for malformed names.
+func repositoryNameLocation(name string) string {
+	matches := repositoryNameRegex.FindStringSubmatch(name)
+	if matches == nil {
+		return ""
+	}
+	return matches[2]
+}
+
+func virtualRepositoryConfigToAPI(config *VirtualRepositoryConfigModel) *artifactregistrydockerimagesclient.VirtualRepositoryConfig {
+	if config == nil {
+		return nil
+	}
+	apiConfig := &artifactregistrydockerimagesclient.VirtualRepositoryConfig{}
+	for _, policy := range config.UpstreamPolicies {
+		apiConfig.UpstreamPolicies = append(apiConfig.UpstreamPolicies, artifactregistrydockerimagesclient.UpstreamPolicy{
+			ID:         policy.ID.ValueString(),
+			Repository: policy.Repository.ValueString(),
+			Priority:   policy.Priority.ValueInt64(),
+		})
+	}
+	return apiConfig
+}
+
+// virtualRepositoryConfigFromAPI converts the config of the API. The API does not keep the order of the upstream
+// policies, so they follow the order of previous, with new policies appended by descending priority.
+func virtualRepositoryConfigFromAPI(apiConfig *artifactregistrydockerimagesclient.VirtualRepositoryConfig, previous *VirtualRepositoryConfigModel) *VirtualRepositoryConfigModel {
+	if apiConfig == nil {
+		return nil
+	}
+	order := map[string]int{}
+	if previous != nil {
+		for i, policy := range previous.UpstreamPolicies {
+			order[policy.ID.ValueString()] = i
+		}
+	}
+	policies := append([]artifactregistrydockerimagesclient.UpstreamPolicy{}, apiConfig.UpstreamPolicies...)
+	sort.SliceStable(policies, func(i, j int) bool {
+		iOrder, iKnown := order[policies[i].ID]
+		jOrder, jKnown := order[policies[j].ID]
+		if iKnown && jKnown {
+			return iOrder < jOrder
+		}
+		if iKnown != jKnown {
+			return iKnown
+		}
+		return policies[i].Priority > policies[j].Priority
+	})
+
+	config := &VirtualRepositoryConfigModel{UpstreamPolicies: make([]UpstreamPolicyModel, 0, len(policies))}
+	for _, policy := range policies {
+		config.UpstreamPolicies = append(config.UpstreamPolicies, UpstreamPolicyModel{
+			ID:         types.StringValue(policy.ID),
+			Repository: types.StringValue(policy.Repository),
+			Priority:   types.Int64Value(policy.Priority),
+		})
+	}
+	return config
+}